@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/linter"
+	"github.com/PrinceMerluza/devcenter-content-linter/scaffold"
+	"github.com/spf13/cobra"
+)
+
+var (
+	initPreset string
+	initData   = &scaffold.Data{}
+)
+
+var initCmd = &cobra.Command{
+	Use:   "init dir --preset blueprint",
+	Short: "Create the skeleton of new content",
+	Long: `Create the skeleton of new content which passes the preset's rules.
+Existing files are never overwritten.
+
+The new content is then checked with the rule set the linter would use for it:
+the --config file, else the closest ` + config.ConfigFileName + `, else the preset.
+Rules it doesn't pass are listed as warnings.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		dir := args[0]
+
+		if initData.Title == "" {
+			absDir, err := filepath.Abs(dir)
+			if err != nil {
+				return err
+			}
+			initData.Title = scaffold.TitleFromDirName(filepath.Base(absDir))
+		}
+
+		if initData.Date == "" {
+			initData.Date = time.Now().Format("2006-01-02")
+		}
+
+		created, err := scaffold.Create(dir, initPreset, initData)
+		if err != nil {
+			return err
+		}
+
+		for _, path := range created {
+			fmt.Printf("Created %s\n", path)
+		}
+
+		return checkScaffold(dir)
+	},
+}
+
+// Run the rule set which applies to the new content on it and warn about the
+// rules it doesn't pass, like required files the templates don't have
+func checkScaffold(dir string) error {
+	source := cfgFile
+	if source == "" {
+		configPath, err := config.FindConfigFile(dir)
+		if err != nil {
+			return err
+		}
+		source = configPath
+	}
+
+	var plan *linter.Plan
+	var err error
+	if source != "" {
+		_, plan, err = loadRuleSetFile(source)
+	} else {
+		source = "preset " + initPreset
+		_, plan, err = loadPreset(initPreset)
+	}
+	if err != nil {
+		return err
+	}
+
+	blueprintrepo.UseRepo(dir, false)
+	result := plan.Run(dir, linter.DefaultRuleTimeout)
+	if len(*result.FailureResults) > 0 {
+		fmt.Fprintf(os.Stderr, "Warning: the new content doesn't pass these rules of %s yet:\n", source)
+	}
+	for _, rule := range *result.FailureResults {
+		if rule.Error != nil {
+			fmt.Fprintf(os.Stderr, "  %s: %s (%v)\n", rule.Id, rule.Description, rule.Error)
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "  %s: %s\n", rule.Id, rule.Description)
+	}
+
+	return nil
+}
+
+func init() {
+	initCmd.Flags().StringVarP(&initPreset, "preset", "p", "blueprint", fmt.Sprintf("type of content (%s)", strings.Join(scaffold.Presets(), ", ")))
+	initCmd.Flags().StringVarP(&cfgFile, "config", "c", "", "config file to check the new content with instead of the closest one or the preset")
+	initCmd.Flags().StringVar(&initData.Title, "title", "", "title of the content. Default is based on the directory name.")
+	initCmd.Flags().StringVar(&initData.Author, "author", "your-github-username", "author of the content")
+	initCmd.Flags().StringVar(&initData.Date, "date", "", "publishing date in the YYYY-MM-DD format. Default is today.")
+	initCmd.Flags().StringVar(&initData.Summary, "summary", "Describe the content in one or two sentences.", "short summary of the content")
+
+	rootCmd.AddCommand(initCmd)
+}
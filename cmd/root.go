@@ -7,54 +7,130 @@ package cmd
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
 	"github.com/PrinceMerluza/devcenter-content-linter/config"
 	"github.com/PrinceMerluza/devcenter-content-linter/linter"
 	"github.com/PrinceMerluza/devcenter-content-linter/logger"
+	"github.com/PrinceMerluza/devcenter-content-linter/report"
 	"github.com/PrinceMerluza/devcenter-content-linter/transform_data"
 	"github.com/PrinceMerluza/devcenter-content-linter/utils"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
+// The result document as JSON, which can be transformed with a template
+const jsonFormat = "json"
+
 var (
-	cfgFile      string
-	isRemoteRepo bool
+	cfgFile          string
+	presetName       string
+	isRemoteRepo     bool
+	outputFormat     string
+	summaryPath      string
+	outputPath       string
+	ruleSetSelection *config.RuleSetSelection
+	ruleSetPlan      *linter.Plan // the compiled config.LoadedRuleSet
 )
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
-	Use:   "gc-linter repo-path --config config.json",
+	Use:   "gc-linter repo-path [--config config.json | --preset name]",
 	Short: "Valdiates content for the Genesys Cloud Developer Center",
 	Long: `The gc-linter is a CLI tool which validates the structure, format, and required files 
 of different Genesys Cloud developer center content. 
 
-Examples of this content are: blueprints.`,
-	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-		return initViperConfig()
+Examples of this content are: blueprints, articles, guides and blog posts.
+
+Without --config or --preset, the rule set is the ` + config.ConfigFileName + ` in the
+content path or the closest parent directory. If there isn't one, the preset is
+chosen from the indextype in the front matter of the content's index.md.`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if !cmd.Flags().Changed("format") {
+			outputFormat = defaultFormat()
+		}
+		if outputFormat != jsonFormat && !report.Exists(outputFormat) {
+			return fmt.Errorf("unknown format %s, must be one of: %s", outputFormat, strings.Join(outputFormats(), ", "))
+		}
+		if outputFormat != jsonFormat && transform_data.TemplateFile != "" {
+			return errors.New("--transform can only be used with the json format")
+		}
+		if outputFormat != "github" && summaryPath != "" {
+			return errors.New("--summary can only be used with the github format")
+		}
+
+		// Rule set errors are reported on their own
+		cmd.SilenceUsage = true
+		if cfgFile == "" && presetName == "" {
+			// Selected once the content is available
+			return nil
+		}
+
+		ruleSetSelection = &config.RuleSetSelection{
+			Method:     config.SelectedByFlag,
+			ConfigFile: cfgFile,
+			Preset:     presetName,
+		}
+		return initRuleSet()
 	},
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		repoPath := args[0]
 
 		blueprintrepo.UseRepo(repoPath, isRemoteRepo)
 
+		if ruleSetSelection == nil {
+			if err := selectRuleSet(blueprintrepo.GetWorkingPath()); err != nil {
+				return err
+			}
+		}
+
 		results := validateContent(blueprintrepo.GetWorkingPath())
-		resultsJsonB, err := json.Marshal(results)
+		results.Metadata = &linter.ResultMetadata{
+			RuleSet: ruleSetSelection,
+		}
+
+		out := os.Stdout
+		if outputPath != "" {
+			file, err := os.Create(outputPath)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			out = file
+		}
+
+		contentReport := &report.Report{
+			Result:      results,
+			RuleSet:     config.LoadedRuleSet,
+			ContentPath: blueprintrepo.GetWorkingPath(),
+			SummaryPath: summaryPath,
+			Color:       isTerminal(out) && os.Getenv("NO_COLOR") == "",
+			Version:     Version,
+		}
+		if outputFormat != jsonFormat {
+			return report.Write(out, outputFormat, contentReport)
+		}
+
+		resultsJsonB, err := json.Marshal(report.NewDocument(contentReport))
 		if err != nil {
 			logger.Fatal(err)
 		}
 
-		utils.Render(string(resultsJsonB))
+		utils.Render(out, string(resultsJsonB))
+		return nil
 	},
-	Args: cobra.ExactArgs(1),
+	Args:    cobra.ExactArgs(1),
+	Version: Version,
 }
 
 func validateContent(repoPath string) *linter.ValidationResult {
 	validationData := &linter.ValidationData{
 		ContentPath: repoPath,
+		RuleSetPath: cfgFile,
 		RuleData:    config.LoadedRuleSet,
+		Plan:        ruleSetPlan,
 	}
 
 	result, err := validationData.Validate()
@@ -65,6 +141,56 @@ func validateContent(repoPath string) *linter.ValidationResult {
 	return result
 }
 
+// Get the names of all output formats
+func outputFormats() []string {
+	return append([]string{jsonFormat}, report.Formats()...)
+}
+
+// Get the format used without --format: pretty for people at a terminal,
+// json for scripts, templates and output files
+func defaultFormat() string {
+	if outputPath == "" && isTerminal(os.Stdout) && transform_data.TemplateFile == "" {
+		return "pretty"
+	}
+
+	return jsonFormat
+}
+
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// Select the rule set for the content when neither --config nor --preset is
+// given: the closest config file, else the preset of the content type
+func selectRuleSet(contentPath string) error {
+	configPath, err := config.FindConfigFile(contentPath)
+	if err != nil {
+		return err
+	}
+
+	if configPath != "" {
+		cfgFile = configPath
+		ruleSetSelection = &config.RuleSetSelection{
+			Method:     config.SelectedByDiscovery,
+			ConfigFile: configPath,
+		}
+	} else {
+		selection, err := config.DetectContentType(contentPath)
+		if err != nil {
+			return fmt.Errorf("%w. Use --config or --preset to choose the rule set", err)
+		}
+		presetName = selection.Preset
+		ruleSetSelection = selection
+	}
+
+	return initRuleSet()
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
@@ -74,25 +200,23 @@ func Execute() {
 	}
 }
 
-// Initizalize the viper config
-// Viper config is a required flag
-func initViperConfig() error {
-	if cfgFile == "" {
-		return errors.New("config file is required")
-	}
-	viper.SetConfigFile(cfgFile)
-
-	if err := viper.ReadInConfig(); err != nil {
-		logger.Fatal("Error reading config file: ", err)
+// Load the rule set given with --config or --preset, or selected for the
+// content. The rule set is validated against the rule set schema and compiled
+// before it's loaded.
+func initRuleSet() error {
+	// The rule set, with the rule sets it extends merged in
+	ruleSet, plan, err := loadRuleSetFlag()
+	if err != nil {
 		return err
 	}
 
-	logger.Info("Using config file: ", viper.ConfigFileUsed())
-
-	// Set the config data
-	if err := viper.Unmarshal(&config.LoadedRuleSet); err != nil {
-		logger.Fatal(err)
+	if presetName != "" {
+		logger.Info("Using preset: ", presetName)
+	} else {
+		logger.Info("Using config file: ", cfgFile)
 	}
+	config.LoadedRuleSet = ruleSet
+	ruleSetPlan = plan
 
 	return nil
 }
@@ -101,8 +225,10 @@ func init() {
 	cobra.OnInitialize()
 
 	// Flags
-	rootCmd.PersistentFlags().StringVarP(&cfgFile, "config", "c", "", "config file that defines the type of content")
-	rootCmd.MarkFlagRequired("config")
+	addRuleSetFlags(rootCmd)
+	rootCmd.Flags().StringVar(&summaryPath, "summary", "", "file to append the Markdown job summary to with the github format, like $GITHUB_STEP_SUMMARY")
+	rootCmd.Flags().StringVarP(&outputPath, "output", "o", "", "file to write the output to instead of stdout, like report.html with the html format")
+	rootCmd.Flags().StringVarP(&outputFormat, "format", "f", "", fmt.Sprintf("output format (%s). Default is pretty at a terminal and json otherwise.", strings.Join(outputFormats(), ", ")))
 
 	rootCmd.PersistentFlags().BoolVarP(&logger.LoggingEnabled, "enable-logging", "l", false, "enable logging")
 	rootCmd.PersistentFlags().BoolVarP(&isRemoteRepo, "remote", "r", false, "if the repo-path is an HTTP URL")
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/linter"
+	"github.com/PrinceMerluza/devcenter-content-linter/presets"
+	"github.com/spf13/cobra"
+)
+
+// rulesCmd groups the commands for working with rule set files
+var rulesCmd = &cobra.Command{
+	Use:   "rules",
+	Short: "Work with rule set files",
+}
+
+// Validate, load and compile the rule set file given with --config, or the
+// built-in rule set given with --preset
+func loadRuleSetFlag() (*config.RuleSet, *linter.Plan, error) {
+	switch {
+	case cfgFile != "" && presetName != "":
+		return nil, nil, errors.New("config file and preset can't be used together")
+	case presetName != "":
+		return loadPreset(presetName)
+	case cfgFile != "":
+		return loadRuleSetFile(cfgFile)
+	}
+
+	return nil, nil, errors.New("config file or preset is required")
+}
+
+// Register the --config and --preset flags used by loadRuleSetFlag
+func addRuleSetFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&cfgFile, "config", "c", "", "config file that defines the type of content")
+	cmd.Flags().StringVarP(&presetName, "preset", "p", "", fmt.Sprintf("built-in rule set to use instead of a config file (%s)", strings.Join(presets.Names(), ", ")))
+}
+
+// Get the name of the rule set given with --config or --preset, for messages
+func ruleSetSource() string {
+	if presetName != "" {
+		return "preset " + presetName
+	}
+
+	return cfgFile
+}
+
+func init() {
+	rootCmd.AddCommand(rulesCmd)
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/linter"
+	"github.com/spf13/cobra"
+)
+
+var rulesExplainCmd = &cobra.Command{
+	Use:   "explain rule-id --config config.json | --preset name",
+	Short: "Explain what a rule checks and how to fix a failure",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		ruleSet, _, err := loadRuleSetFlag()
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range ruleSet.Entries() {
+			if strings.EqualFold(entry.Id, args[0]) {
+				fmt.Print(explainRule(entry, (*ruleSet.RuleGroups)[entry.Group].Description))
+				return nil
+			}
+		}
+
+		return fmt.Errorf("rule %s not found in %s", args[0], ruleSetSource())
+	},
+}
+
+// Get the readable explanation of the rule
+func explainRule(entry config.RuleEntry, groupDescription string) string {
+	rule := entry.Rule
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s (%s)\n\n", entry.Id, rule.Level)
+	fmt.Fprintf(&sb, "%s\n\n", strings.Join(strings.Fields(rule.Description), " "))
+	fmt.Fprintf(&sb, "Group: %s - %s\n", entry.Group, groupDescription)
+	if rule.File != nil {
+		fmt.Fprintf(&sb, "File:  %s\n", *rule.File)
+	}
+	if rule.Disabled {
+		sb.WriteString("\nThe rule is disabled.\n")
+	}
+
+	sb.WriteString("\nConditions:\n")
+	if rule.Conditions != nil {
+		for i := range *rule.Conditions {
+			for _, description := range linter.DescribeCondition(&(*rule.Conditions)[i]) {
+				fmt.Fprintf(&sb, "  - %s\n", description)
+			}
+		}
+	}
+
+	if rule.Examples != nil {
+		for _, example := range rule.Examples.Pass {
+			fmt.Fprintf(&sb, "\nPassing example:\n%s", indent(example))
+		}
+		for _, example := range rule.Examples.Fail {
+			fmt.Fprintf(&sb, "\nFailing example:\n%s", indent(example))
+		}
+	}
+
+	if rule.Remediation != "" {
+		fmt.Fprintf(&sb, "\nHow to fix:\n%s", indent(rule.Remediation))
+	}
+
+	return sb.String()
+}
+
+// Indent every line of the text
+func indent(text string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = "    " + line
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func init() {
+	addRuleSetFlags(rulesExplainCmd)
+
+	rulesCmd.AddCommand(rulesExplainCmd)
+}
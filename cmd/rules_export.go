@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/presets"
+	"github.com/spf13/cobra"
+)
+
+var rulesExportCmd = &cobra.Command{
+	Use:   "export preset",
+	Short: "Print a built-in rule set as a starting point for a custom one",
+	Long: fmt.Sprintf(`Print a built-in rule set as a starting point for a custom one.
+To change only a few rules, extend the preset in the custom rule set instead.
+
+Presets: %s`, strings.Join(presets.Names(), ", ")),
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: presets.Names(),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		data, err := presets.Get(args[0])
+		if err != nil {
+			return err
+		}
+
+		_, err = os.Stdout.Write(data)
+		return err
+	},
+}
+
+func init() {
+	rulesCmd.AddCommand(rulesExportCmd)
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/ruletest"
+	"github.com/spf13/cobra"
+)
+
+var updateExpected bool
+
+var rulesTestCmd = &cobra.Command{
+	Use:   "test fixtures-dir --config config.json | --preset name",
+	Short: "Run the rule set against fixtures and compare the findings",
+	Long: `Run the rule set against every fixture folder in fixtures-dir and compare the
+findings with the expected ones. The expected findings of a folder are in a
+sidecar file next to it, e.g. broken-link` + ruletest.ExpectedSuffix + ` for broken-link:
+
+  findings:
+  - rule: link_0
+    file: blueprint/index.md
+    line: 12
+  - rule: struct_1
+  errors:
+  - struct_2
+
+Rules which can't be evaluated, like a rule whose file is missing, are errors
+rather than findings, and must be listed under errors.
+
+Fails if any fixture has missing or unexpected findings or errors.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		_, plan, err := loadRuleSetFlag()
+		if err != nil {
+			return err
+		}
+
+		fixtures, err := ruletest.Discover(args[0])
+		if err != nil {
+			return err
+		}
+		if len(fixtures) == 0 {
+			return fmt.Errorf("no fixtures in %s: fixture folders need a %s file next to them", args[0], ruletest.ExpectedSuffix)
+		}
+
+		failed := 0
+		for _, fixture := range fixtures {
+			report, err := ruletest.Run(fixture, plan)
+			if err != nil {
+				return fmt.Errorf("%s: %w", fixture.Name, err)
+			}
+
+			if updateExpected {
+				if err := ruletest.SaveExpected(fixture.ExpectedPath, report.Expectation()); err != nil {
+					return err
+				}
+				fmt.Printf("UPDATED %s\n", fixture.Name)
+				continue
+			}
+
+			if report.Passed() {
+				fmt.Printf("PASS %s\n", fixture.Name)
+				continue
+			}
+
+			failed++
+			fmt.Printf("FAIL %s\n", fixture.Name)
+			for _, finding := range report.Missing {
+				fmt.Printf("  - %s (expected, not found)\n", finding)
+			}
+			for _, finding := range report.Unexpected {
+				fmt.Printf("  + %s (found, not expected)\n", finding)
+			}
+			for _, rule := range report.MissingErrors {
+				fmt.Printf("  - %s (error expected, evaluated)\n", rule)
+			}
+			for _, ruleError := range report.UnexpectedErrors {
+				fmt.Printf("  ! %s (error, not expected)\n", ruleError)
+			}
+		}
+
+		fmt.Printf("\n%d fixtures, %d failed\n", len(fixtures), failed)
+		if failed > 0 {
+			return fmt.Errorf("%d of %d fixtures failed", failed, len(fixtures))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	addRuleSetFlags(rulesTestCmd)
+	rulesTestCmd.Flags().BoolVar(&updateExpected, "update", false, "write the current findings to the sidecar files instead of comparing them")
+
+	rulesCmd.AddCommand(rulesTestCmd)
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	listGroup  string
+	listLevel  string
+	listFormat string
+)
+
+// A rule in the JSON output of rules list
+type ruleListItem struct {
+	Id          string `json:"id"`
+	Group       string `json:"group"`
+	Level       string `json:"level"`
+	File        string `json:"file,omitempty"`
+	Description string `json:"description"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+var rulesListCmd = &cobra.Command{
+	Use:   "list --config config.json | --preset name",
+	Short: "List the rules of a rule set",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		ruleSet, _, err := loadRuleSetFlag()
+		if err != nil {
+			return err
+		}
+
+		items := []ruleListItem{}
+		for _, entry := range ruleSet.Entries() {
+			if entry.Rule.Disabled {
+				continue
+			}
+			if listGroup != "" && !strings.EqualFold(entry.Group, listGroup) {
+				continue
+			}
+			if listLevel != "" && !strings.EqualFold(string(entry.Rule.Level), listLevel) {
+				continue
+			}
+
+			item := ruleListItem{
+				Id:          entry.Id,
+				Group:       entry.Group,
+				Level:       string(entry.Rule.Level),
+				Description: entry.Rule.Description,
+				Remediation: entry.Rule.Remediation,
+			}
+			if entry.Rule.File != nil {
+				item.File = *entry.Rule.File
+			}
+			items = append(items, item)
+		}
+
+		switch listFormat {
+		case "json":
+			itemsJsonB, err := json.MarshalIndent(items, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(itemsJsonB))
+		case "table":
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tLEVEL\tFILE\tDESCRIPTION")
+			for _, item := range items {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.Id, item.Level, item.File, truncate(item.Description, 80))
+			}
+			return w.Flush()
+		default:
+			return fmt.Errorf("unknown format %s", listFormat)
+		}
+
+		return nil
+	},
+}
+
+// Shorten the text to a single line of at most max characters
+func truncate(text string, max int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+
+	return string(runes[:max-3]) + "..."
+}
+
+func init() {
+	addRuleSetFlags(rulesListCmd)
+	rulesListCmd.Flags().StringVarP(&listGroup, "group", "g", "", "only list the rules of the group")
+	rulesListCmd.Flags().StringVar(&listLevel, "level", "", "only list the rules with the level (warning or error)")
+	rulesListCmd.Flags().StringVarP(&listFormat, "format", "f", "table", "output format (table or json)")
+
+	rulesCmd.AddCommand(rulesListCmd)
+}
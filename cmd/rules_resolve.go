@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var resolveFormat string
+
+var rulesResolveCmd = &cobra.Command{
+	Use:   "resolve --config config.json | --preset name",
+	Short: "Print the rule set with the rule sets it extends merged in",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		ruleSet, _, err := loadRuleSetFlag()
+		if err != nil {
+			return err
+		}
+
+		ruleSetJsonB, err := json.MarshalIndent(ruleSet, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		switch resolveFormat {
+		case "json":
+			fmt.Println(string(ruleSetJsonB))
+		case "yaml":
+			return writeJsonAsYaml(ruleSetJsonB)
+		default:
+			return fmt.Errorf("unknown format %s", resolveFormat)
+		}
+
+		return nil
+	},
+}
+
+// Print the JSON document as YAML, keeping the order of the keys
+func writeJsonAsYaml(data []byte) error {
+	node := &yaml.Node{}
+	if err := yaml.Unmarshal(data, node); err != nil {
+		return err
+	}
+	clearYamlStyle(node)
+
+	encoder := yaml.NewEncoder(os.Stdout)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(node); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+// Use the block style instead of the JSON flow style, and only quote strings
+// when needed
+func clearYamlStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearYamlStyle(child)
+	}
+}
+
+func init() {
+	addRuleSetFlags(rulesResolveCmd)
+	rulesResolveCmd.Flags().StringVarP(&resolveFormat, "format", "f", "yaml", "output format (yaml or json)")
+
+	rulesCmd.AddCommand(rulesResolveCmd)
+}
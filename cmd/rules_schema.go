@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/schemas"
+	"github.com/spf13/cobra"
+)
+
+var rulesSchemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Print the JSON Schema of rule set files",
+	Long: `Print the JSON Schema of rule set files. Editors can use it for
+validation and autocomplete of rule sets.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_, err := os.Stdout.Write(schemas.RuleSetSchema)
+		return err
+	},
+}
+
+func init() {
+	rulesCmd.AddCommand(rulesSchemaCmd)
+}
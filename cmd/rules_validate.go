@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/linter"
+	"github.com/spf13/cobra"
+)
+
+var rulesValidateCmd = &cobra.Command{
+	Use:   "validate rule-file",
+	Short: "Validate a rule set file against the rule set schema",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		if _, _, err := loadRuleSetFile(args[0]); err != nil {
+			return err
+		}
+
+		fmt.Printf("%s is valid\n", args[0])
+		return nil
+	},
+}
+
+// Validate the rule set file against the schema, then resolve and compile it.
+// The returned error lists every schema violation with the file, line and JSON
+// pointer of the invalid value, or every compile error.
+func loadRuleSetFile(path string) (*config.RuleSet, *linter.Plan, error) {
+	if err := config.CheckRuleSetFile(path); err != nil {
+		return nil, nil, err
+	}
+
+	ruleSet, err := config.ResolveRuleSet(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Problems the schema can't describe, like invalid regexes
+	plan, err := compileRuleSet(ruleSet, path, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ruleSet, plan, nil
+}
+
+// Resolve and compile the built-in rule set
+func loadPreset(name string) (*config.RuleSet, *linter.Plan, error) {
+	ruleSet, err := config.ResolvePreset(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plan, err := compileRuleSet(ruleSet, "", "preset "+name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ruleSet, plan, nil
+}
+
+// Compile the rule set, listing every compile error prefixed by source
+func compileRuleSet(ruleSet *config.RuleSet, ruleSetPath string, source string) (*linter.Plan, error) {
+	plan, err := linter.Compile(ruleSet, ruleSetPath)
+	if err != nil {
+		var compileErrs linter.CompileErrors
+		if !errors.As(err, &compileErrs) {
+			return nil, err
+		}
+
+		lines := []string{}
+		for _, compileErr := range compileErrs {
+			lines = append(lines, fmt.Sprintf("%s: %s", source, compileErr.Error()))
+		}
+
+		return nil, fmt.Errorf("invalid rule set:\n%s", strings.Join(lines, "\n"))
+	}
+
+	return plan, nil
+}
+
+func init() {
+	rulesCmd.AddCommand(rulesValidateCmd)
+}
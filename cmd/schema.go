@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/schemas"
+	"github.com/spf13/cobra"
+)
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Print the JSON Schema of the result document",
+	Long: `Print the JSON Schema of the result document which the json format writes.
+Its schemaVersion is the version of the document: the minor version changes
+when fields are added, the major version when fields are changed or removed.
+
+The JSON Schema of rule set files is printed by "rules schema".`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_, err := os.Stdout.Write(schemas.ResultSchema)
+		return err
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(schemaCmd)
+}
@@ -0,0 +1,5 @@
+package cmd
+
+// Version of the linter, set when building releases with
+// -ldflags "-X github.com/PrinceMerluza/devcenter-content-linter/cmd.Version=v1.2.3"
+var Version = "dev"
@@ -1,5 +1,13 @@
 package config
 
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/viper"
+)
+
 var (
 	LoadedRuleSet *RuleSet
 )
@@ -13,31 +21,177 @@ const (
 )
 
 type RuleSet struct {
-	Name        string
-	Description string
-	RuleGroups  *map[string]RuleGroup
+	Name        string                   `json:"name,omitempty"`
+	Description string                   `json:"description,omitempty"`
+	Extends     []string                 `json:"extends,omitempty"`   // paths relative to this file, or preset names
+	Overrides   *map[string]RuleOverride `json:"overrides,omitempty"` // changes to rules by rule ID
+	Disable     []string                 `json:"disable,omitempty"`   // IDs of rules to disable
+	RuleGroups  *map[string]RuleGroup    `json:"ruleGroups,omitempty"`
 }
 
 type RuleGroup struct {
-	Description string
-	Rules       *[]Rule
+	Description string  `json:"description,omitempty"`
+	Rules       *[]Rule `json:"rules,omitempty"`
 }
 
 type Rule struct {
-	Description string
-	File        *string
-	Conditions  *[]Condition
-	Level       Level
+	Description string        `json:"description,omitempty"`
+	File        *string       `json:"file,omitempty"`
+	Conditions  *[]Condition  `json:"conditions,omitempty"`
+	Level       Level         `json:"level,omitempty"`
+	Remediation string        `json:"remediation,omitempty"` // how to fix a failure of the rule
+	Examples    *RuleExamples `json:"examples,omitempty"`    // content snippets shown when explaining the rule
+	Disabled    bool          `json:"disabled,omitempty"`    // the rule is kept so the IDs of the next rules don't change
+}
+
+// Changes to an inherited rule. Only the defined fields are replaced.
+type RuleOverride struct {
+	Description string       `json:"description,omitempty"`
+	Conditions  *[]Condition `json:"conditions,omitempty"`
+	Level       Level        `json:"level,omitempty"`
+	Remediation string       `json:"remediation,omitempty"`
+}
+
+type RuleExamples struct {
+	Pass []string `json:"pass,omitempty"`
+	Fail []string `json:"fail,omitempty"`
 }
 
 type Condition struct {
-	PathExists          *string
-	Contains            *[]ContainsCondition
-	NotContains         *[]string
-	CheckReferenceExist *[]string
+	PathExists          *string                  `json:"pathExists,omitempty"`
+	PathNotExists       *[]string                `json:"pathNotExists,omitempty"` // glob patterns which must not match any path
+	AllowedEntries      *AllowedEntriesCondition `json:"allowedEntries,omitempty"`
+	PathNaming          *PathNamingCondition     `json:"pathNaming,omitempty"`
+	Contains            *[]ContainsCondition     `json:"contains,omitempty"`
+	NotContains         *[]string                `json:"notContains,omitempty"`
+	CheckReferenceExist *[]string                `json:"checkReferenceExist,omitempty"`
+	JsonSchema          *string                  `json:"jsonSchema,omitempty"` // path to the schema file, relative to the rule file
+	CodeBlocks          *CodeBlocksCondition     `json:"codeBlocks,omitempty"`
+	TextHygiene         *TextHygieneCondition    `json:"textHygiene,omitempty"`
+	Secrets             *SecretsCondition        `json:"secrets,omitempty"`
+	Terraform           *TerraformCondition      `json:"terraform,omitempty"`
+	Dir                 string                   `json:"-" mapstructure:"-"` // directory of the extended rule set file which declares the condition, set when resolving
 }
 
 type ContainsCondition struct {
-	Type  string // static or regex
-	Value string
+	Type  string `json:"type,omitempty"` // static or regex
+	Value string `json:"value,omitempty"`
+}
+
+type AllowedEntriesCondition struct {
+	Path    string   `json:"path,omitempty"`    // directory to check, relative to the rule's path
+	Allowed []string `json:"allowed,omitempty"` // glob patterns relative to Path. Matching a directory allows everything inside it.
+}
+
+type PathNamingCondition struct {
+	Files          []string `json:"files,omitempty"`          // glob patterns of paths to check, relative to the rule's path. Default is everything.
+	Preset         string   `json:"preset,omitempty"`         // kebab-case, lowercase or no-spaces
+	Pattern        string   `json:"pattern,omitempty"`        // regex the file or directory name must match
+	MaxLength      int      `json:"maxLength,omitempty"`      // maximum length of the name
+	CaseCollisions bool     `json:"caseCollisions,omitempty"` // report paths that differ only in case
+}
+
+type CodeBlocksCondition struct {
+	RequireLanguage  bool     `json:"requireLanguage,omitempty"`  // every fenced code block must have a language tag
+	AllowedLanguages []string `json:"allowedLanguages,omitempty"` // if defined, language tags must be one of these
+	ValidateSyntax   bool     `json:"validateSyntax,omitempty"`   // parse json, yaml, xml and hcl code blocks
+}
+
+type TextHygieneCondition struct {
+	Files                []string `json:"files,omitempty"`       // glob patterns of files to check, relative to the rule's path
+	Utf8                 bool     `json:"utf8,omitempty"`        // file must be valid UTF-8
+	NoBom                bool     `json:"noBom,omitempty"`       // file must not start with a UTF-8 byte order mark
+	LineEndings          string   `json:"lineEndings,omitempty"` // lf or crlf
+	NoTrailingWhitespace bool     `json:"noTrailingWhitespace,omitempty"`
+	MaxLineLength        int      `json:"maxLineLength,omitempty"`
+	NoFrontMatterTabs    bool     `json:"noFrontMatterTabs,omitempty"`   // no tab characters in the YAML front matter
+	NoSuspiciousUnicode  bool     `json:"noSuspiciousUnicode,omitempty"` // no zero-width or non-breaking spaces, no smart quotes in code
+}
+
+type SecretsCondition struct {
+	Files      []string            `json:"files,omitempty"`      // glob patterns of files to scan, relative to the rule's path
+	Detectors  []string            `json:"detectors,omitempty"`  // built-in detectors to use. Default is all of them.
+	MinEntropy float64             `json:"minEntropy,omitempty"` // report strings with at least this Shannon entropy. 0 disables the check.
+	Allowlist  map[string][]string `json:"allowlist,omitempty"`  // regex of values to ignore (e.g. placeholders), per detector name or * for all
+}
+
+type TerraformCondition struct {
+	Files                 []string `json:"files,omitempty"`                 // glob patterns of Terraform files, relative to the rule's path. Default is **/*.tf
+	PinnedProviders       []string `json:"pinnedProviders,omitempty"`       // provider sources which must have a version constraint, e.g. mypurecloud/genesyscloud
+	NoHardcodedAttributes []string `json:"noHardcodedAttributes,omitempty"` // attributes which must not have literal values, e.g. oauthclient_secret
+}
+
+// Get the ID of the rule from its group and index in the group
+func RuleId(groupId string, index int) string {
+	return fmt.Sprintf("%s_%v", groupId, index)
+}
+
+// A rule of the rule set with its ID and group
+type RuleEntry struct {
+	Id    string
+	Group string
+	Rule  Rule
+}
+
+// Get the rules of the rule set, ordered by group and position in the group
+func (ruleSet *RuleSet) Entries() []RuleEntry {
+	ret := []RuleEntry{}
+	if ruleSet.RuleGroups == nil {
+		return ret
+	}
+
+	groupIds := []string{}
+	for id := range *ruleSet.RuleGroups {
+		groupIds = append(groupIds, id)
+	}
+	sort.Strings(groupIds)
+
+	for _, groupId := range groupIds {
+		group := (*ruleSet.RuleGroups)[groupId]
+		if group.Rules == nil {
+			continue
+		}
+		for i, rule := range *group.Rules {
+			ret = append(ret, RuleEntry{
+				Id:    RuleId(groupId, i),
+				Group: groupId,
+				Rule:  rule,
+			})
+		}
+	}
+
+	return ret
+}
+
+// Load the rule set file without resolving the rule sets it extends
+func LoadRuleSet(path string) (*RuleSet, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	return unmarshalRuleSet(v)
+}
+
+// Load the rule set from YAML or JSON data
+func LoadRuleSetData(data []byte) (*RuleSet, error) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+
+	if err := v.ReadConfig(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	return unmarshalRuleSet(v)
+}
+
+func unmarshalRuleSet(v *viper.Viper) (*RuleSet, error) {
+	ruleSet := &RuleSet{}
+	if err := v.Unmarshal(ruleSet); err != nil {
+		return nil, err
+	}
+
+	return ruleSet, nil
 }
@@ -0,0 +1,146 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/presets"
+	"gopkg.in/yaml.v3"
+)
+
+// Name of the config file found by walking up from the content path
+const ConfigFileName = ".gc-linter.yaml"
+
+// Ways the rule set can be selected
+const (
+	SelectedByFlag      = "flag"      // --config or --preset
+	SelectedByDiscovery = "discovery" // config file found in a parent directory
+	SelectedByDetection = "detection" // preset of the content type in the front matter
+)
+
+// Preset names for index types which don't match a preset name
+var indexTypeAliases = map[string]string{
+	"blog":      "blogpost",
+	"blog-post": "blogpost",
+}
+
+// How the rule set for the content was selected
+type RuleSetSelection struct {
+	Method     string `json:"method"`
+	ConfigFile string `json:"configFile,omitempty"`
+	Preset     string `json:"preset,omitempty"`
+	IndexType  string `json:"indexType,omitempty"`
+	DetectedIn string `json:"detectedIn,omitempty"` // file with the index type
+}
+
+// Find the config file in the content path or the closest parent directory.
+// The search stops at the root of the git repository, so a config file
+// outside of it is never used. Returns an empty path if there isn't one.
+func FindConfigFile(contentPath string) (string, error) {
+	dir, err := filepath.Abs(contentPath)
+	if err != nil {
+		return "", err
+	}
+	if info, err := os.Stat(dir); err == nil && !info.IsDir() {
+		dir = filepath.Dir(dir)
+	}
+
+	for {
+		configPath := filepath.Join(dir, ConfigFileName)
+		if info, err := os.Stat(configPath); err == nil && !info.IsDir() {
+			return configPath, nil
+		}
+
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return "", nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// Detect the content type from the indextype in the front matter of the
+// index.md, either in the content path or one directory below it like
+// blueprint/index.md. Returns the selection with the matching preset.
+func DetectContentType(contentPath string) (*RuleSetSelection, error) {
+	candidates := []string{filepath.Join(contentPath, "index.md")}
+	nested, err := filepath.Glob(filepath.Join(contentPath, "*", "index.md"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(nested)
+	candidates = append(candidates, nested...)
+
+	for _, candidate := range candidates {
+		indexType, err := readIndexType(candidate)
+		if err != nil {
+			return nil, err
+		}
+		if indexType == "" {
+			continue
+		}
+
+		preset := strings.ToLower(indexType)
+		if alias, ok := indexTypeAliases[preset]; ok {
+			preset = alias
+		}
+		if !presets.Exists(preset) {
+			return nil, fmt.Errorf("%s: no preset for indextype %s, must be one of: %s", candidate, indexType, strings.Join(presets.Names(), ", "))
+		}
+
+		return &RuleSetSelection{
+			Method:     SelectedByDetection,
+			Preset:     preset,
+			IndexType:  indexType,
+			DetectedIn: candidate,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("can't detect the content type of %s: no index.md with an indextype in its front matter", contentPath)
+}
+
+// Get the indextype in the front matter of the file. Returns an empty string
+// if the file or the field doesn't exist.
+func readIndexType(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	frontMatter := []string{}
+	scanner := bufio.NewScanner(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	for lineNumber := 0; scanner.Scan(); lineNumber++ {
+		line := strings.TrimRight(scanner.Text(), " \r")
+		if line == "---" {
+			if lineNumber == 0 {
+				continue
+			}
+			break
+		}
+		if lineNumber == 0 {
+			return "", nil
+		}
+		frontMatter = append(frontMatter, line)
+	}
+
+	fields := struct {
+		IndexType string `yaml:"indextype"`
+	}{}
+	if err := yaml.Unmarshal([]byte(strings.Join(frontMatter, "\n")), &fields); err != nil {
+		return "", fmt.Errorf("%s: invalid front matter: %w", path, err)
+	}
+
+	return fields.IndexType, nil
+}
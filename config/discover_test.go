@@ -0,0 +1,110 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFindConfigFile(t *testing.T) {
+	root := t.TempDir()
+	contentPath := filepath.Join(root, "articles", "webhooks")
+	for _, dir := range []string{filepath.Join(root, ".git"), contentPath} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+	}
+
+	got, err := FindConfigFile(contentPath)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if got != "" {
+		t.Errorf("found %s outside of the repository", got)
+	}
+
+	for _, configPath := range []string{
+		filepath.Join(root, ConfigFileName),
+		filepath.Join(root, "articles", ConfigFileName),
+	} {
+		if err := os.WriteFile(configPath, []byte("name: Test\n"), 0644); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+
+		got, err := FindConfigFile(contentPath)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		if got != configPath {
+			t.Errorf("found %s, want %s", got, configPath)
+		}
+	}
+}
+
+func TestDetectContentType(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentPath string
+		want        *RuleSetSelection
+	}{
+		{
+			name:        "Blueprint",
+			contentPath: "test/detect/blueprint",
+			want: &RuleSetSelection{
+				Method:     SelectedByDetection,
+				Preset:     "blueprint",
+				IndexType:  "blueprint",
+				DetectedIn: "test/detect/blueprint/blueprint/index.md",
+			},
+		},
+		{
+			name:        "Article With CRLF",
+			contentPath: "test/detect/article",
+			want: &RuleSetSelection{
+				Method:     SelectedByDetection,
+				Preset:     "article",
+				IndexType:  "article",
+				DetectedIn: "test/detect/article/index.md",
+			},
+		},
+		{
+			name:        "Alias",
+			contentPath: "test/detect/blog",
+			want: &RuleSetSelection{
+				Method:     SelectedByDetection,
+				Preset:     "blogpost",
+				IndexType:  "blog",
+				DetectedIn: "test/detect/blog/index.md",
+			},
+		},
+		{
+			name:        "No Front Matter",
+			contentPath: "test/detect/none",
+			want:        nil,
+		},
+		{
+			name:        "Unknown Index Type",
+			contentPath: "test/detect/unknown",
+			want:        nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectContentType(tt.contentPath)
+			if tt.want == nil {
+				if err == nil {
+					t.Errorf("detected %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Error: %v", err)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("%v", cmp.Diff(got, tt.want))
+			}
+		})
+	}
+}
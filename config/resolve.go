@@ -0,0 +1,245 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/presets"
+)
+
+// Load the rule set file and merge it with the rule sets it extends.
+//
+// The extended rule sets are merged in order, then the rule set itself is
+// merged in: groups with a new ID are added, and the rules of a group which
+// already exists are appended to it so the IDs of inherited rules don't
+// change. Overrides and disabled rules are applied last and must refer to
+// existing rules. The result doesn't extend anything.
+func ResolveRuleSet(path string) (*RuleSet, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleSet, err := LoadRuleSet(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveRuleSet(ruleSet, filepath.Dir(absPath), []string{absPath})
+}
+
+// Get the preset with the rule sets it extends merged in
+func ResolvePreset(name string) (*RuleSet, error) {
+	ruleSet, err := loadExtended(name, "", []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return ruleSet, nil
+}
+
+// chain is the list of rule sets being resolved, to detect cycles
+func resolveRuleSet(ruleSet *RuleSet, dir string, chain []string) (*RuleSet, error) {
+	merged := &RuleSet{
+		RuleGroups: &map[string]RuleGroup{},
+	}
+
+	for _, ref := range ruleSet.Extends {
+		parent, err := loadExtended(ref, dir, chain)
+		if err != nil {
+			return nil, err
+		}
+		merged = mergeRuleSets(merged, parent)
+	}
+	merged = mergeRuleSets(merged, ruleSet)
+
+	if ruleSet.Overrides != nil {
+		for id, override := range *ruleSet.Overrides {
+			rule := findRule(merged, id)
+			if rule == nil {
+				return nil, fmt.Errorf("can't override rule %s: rule not found", id)
+			}
+
+			if override.Description != "" {
+				rule.Description = override.Description
+			}
+			if override.Conditions != nil {
+				rule.Conditions = override.Conditions
+			}
+			if override.Level != Undefined {
+				rule.Level = override.Level
+			}
+			if override.Remediation != "" {
+				rule.Remediation = override.Remediation
+			}
+		}
+	}
+
+	for _, id := range ruleSet.Disable {
+		rule := findRule(merged, id)
+		if rule == nil {
+			return nil, fmt.Errorf("can't disable rule %s: rule not found", id)
+		}
+		rule.Disabled = true
+	}
+
+	return merged, nil
+}
+
+// Load and resolve an extended rule set. References ending in .yaml, .yml or
+// .json are paths relative to dir, anything else is a preset name.
+func loadExtended(ref string, dir string, chain []string) (*RuleSet, error) {
+	isPath := false
+	for _, ext := range []string{".yaml", ".yml", ".json"} {
+		if strings.EqualFold(filepath.Ext(ref), ext) {
+			isPath = true
+		}
+	}
+
+	key := "preset:" + ref
+	var data []byte
+	if isPath {
+		if !filepath.IsAbs(ref) {
+			ref = filepath.Join(dir, ref)
+		}
+		key = ref
+
+		fileData, err := os.ReadFile(ref)
+		if err != nil {
+			return nil, err
+		}
+		data = fileData
+	} else {
+		presetData, err := presets.Get(ref)
+		if err != nil {
+			return nil, err
+		}
+		data = presetData
+	}
+
+	for _, visited := range chain {
+		if visited == key {
+			return nil, fmt.Errorf("rule set %s extends itself: %s", ref, strings.Join(append(chain, key), " -> "))
+		}
+	}
+
+	if err := checkRuleSetData(ref, data); err != nil {
+		return nil, err
+	}
+
+	ruleSet, err := LoadRuleSetData(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ref, err)
+	}
+
+	// Files referenced by the extended rule set are relative to it
+	if isPath {
+		setConditionDirs(ruleSet, filepath.Dir(ref))
+	}
+
+	return resolveRuleSet(ruleSet, filepath.Dir(ref), append(chain, key))
+}
+
+// Merge the child into a copy of the base rule set
+func mergeRuleSets(base *RuleSet, child *RuleSet) *RuleSet {
+	ret := &RuleSet{
+		Name:        base.Name,
+		Description: base.Description,
+		RuleGroups:  &map[string]RuleGroup{},
+	}
+	if child.Name != "" {
+		ret.Name = child.Name
+	}
+	if child.Description != "" {
+		ret.Description = child.Description
+	}
+
+	for _, ruleSet := range []*RuleSet{base, child} {
+		if ruleSet.RuleGroups == nil {
+			continue
+		}
+
+		for id, group := range *ruleSet.RuleGroups {
+			mergedId := id
+			for existingId := range *ret.RuleGroups {
+				if strings.EqualFold(existingId, id) {
+					mergedId = existingId
+				}
+			}
+
+			merged := (*ret.RuleGroups)[mergedId]
+			if group.Description != "" {
+				merged.Description = group.Description
+			}
+
+			rules := []Rule{}
+			if merged.Rules != nil {
+				rules = append(rules, *merged.Rules...)
+			}
+			if group.Rules != nil {
+				rules = append(rules, *group.Rules...)
+			}
+			merged.Rules = &rules
+
+			(*ret.RuleGroups)[mergedId] = merged
+		}
+	}
+
+	return ret
+}
+
+// Find the rule by ID. IDs are case-insensitive.
+func findRule(ruleSet *RuleSet, id string) *Rule {
+	if ruleSet.RuleGroups == nil {
+		return nil
+	}
+
+	for groupId, group := range *ruleSet.RuleGroups {
+		if group.Rules == nil {
+			continue
+		}
+		for i := range *group.Rules {
+			if strings.EqualFold(RuleId(groupId, i), id) {
+				return &(*group.Rules)[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// Remember the directory of the rule set file which declares the conditions,
+// since the relative paths to files in them are relative to it
+func setConditionDirs(ruleSet *RuleSet, dir string) {
+	if ruleSet.RuleGroups == nil {
+		return
+	}
+
+	for _, group := range *ruleSet.RuleGroups {
+		if group.Rules == nil {
+			continue
+		}
+		for _, rule := range *group.Rules {
+			if rule.Conditions == nil {
+				continue
+			}
+			for i := range *rule.Conditions {
+				(*rule.Conditions)[i].Dir = dir
+			}
+		}
+	}
+
+	if ruleSet.Overrides == nil {
+		return
+	}
+	for _, override := range *ruleSet.Overrides {
+		if override.Conditions == nil {
+			continue
+		}
+		for i := range *override.Conditions {
+			(*override.Conditions)[i].Dir = dir
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResolveRuleSet(t *testing.T) {
+	ruleSet, err := ResolveRuleSet("test/extends/child.rule.yaml")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	// Rules are identified by ID, level and whether they're disabled
+	got := map[string]string{}
+	for groupId, group := range *ruleSet.RuleGroups {
+		for i, rule := range *group.Rules {
+			state := string(rule.Level)
+			if rule.Disabled {
+				state = "disabled"
+			}
+			got[RuleId(groupId, i)] = state
+		}
+	}
+	want := map[string]string{
+		"struct_0": "warning",
+		"struct_1": "disabled",
+		"struct_2": "warning",
+		"data_0":   "error",
+		"link_0":   "warning",
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("%v", cmp.Diff(got, want))
+	}
+
+	if ruleSet.Name != "Child Rules" {
+		t.Errorf("name is %s, want Child Rules", ruleSet.Name)
+	}
+	if description := (*ruleSet.RuleGroups)["struct"].Description; description != "Required files and folders" {
+		t.Errorf("group description is %s, want Required files and folders", description)
+	}
+	if remediation := (*(*ruleSet.RuleGroups)["struct"].Rules)[0].Remediation; remediation != "Add a README.md" {
+		t.Errorf("remediation is %s, want Add a README.md", remediation)
+	}
+
+	// Paths in the extended rule set stay as written, relative to its own file
+	conditions := *(*(*ruleSet.RuleGroups)["data"].Rules)[0].Conditions
+	if *conditions[0].JsonSchema != "./integration.schema.json" {
+		t.Errorf("schema path is %s, want ./integration.schema.json", *conditions[0].JsonSchema)
+	}
+	wantDir, _ := filepath.Abs("test/extends/base")
+	if conditions[0].Dir != wantDir {
+		t.Errorf("condition dir is %s, want %s", conditions[0].Dir, wantDir)
+	}
+}
+
+func TestResolveRuleSet_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{
+			name: "Cycle",
+			path: "test/extends/cycle-a.rule.yaml",
+			want: "extends itself",
+		},
+		{
+			name: "Unknown Override",
+			path: "test/extends/unknown-override.rule.yaml",
+			want: "can't override rule struct_99",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ResolveRuleSet(tt.path)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error is %v, want %s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolvePreset(t *testing.T) {
+	tests := []struct {
+		preset string
+		want   string
+	}{
+		{preset: "article", want: "Article Rules"},
+		{preset: "blogpost", want: "Blog Post Rules"},
+		{preset: "blueprint", want: "Blueprint Rules"},
+		{preset: "guide", want: "Guide Rules"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.preset, func(t *testing.T) {
+			ruleSet, err := ResolvePreset(tt.preset)
+			if err != nil {
+				t.Fatalf("Error: %v", err)
+			}
+			if ruleSet.Name != tt.want {
+				t.Errorf("name is %s, want %s", ruleSet.Name, tt.want)
+			}
+		})
+	}
+
+	if _, err := ResolvePreset("unknown"); err == nil {
+		t.Errorf("unknown preset must be an error")
+	}
+}
@@ -0,0 +1,134 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/schemas"
+	"github.com/PrinceMerluza/devcenter-content-linter/utils"
+)
+
+// The rule set schema must describe exactly the fields of the config types
+// so rule sets which pass validation are fully loaded, and vice versa.
+func TestRuleSetSchema_MatchesTypes(t *testing.T) {
+	var schema map[string]interface{}
+	if err := json.Unmarshal(schemas.RuleSetSchema, &schema); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	checkSchemaType(t, schema, "", reflect.TypeOf(RuleSet{}), schema)
+}
+
+func TestRuleSetSchema_Levels(t *testing.T) {
+	var schema map[string]interface{}
+	if err := json.Unmarshal(schemas.RuleSetSchema, &schema); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	rule := schema["properties"].(map[string]interface{})["ruleGroups"].(map[string]interface{})
+	for _, pointer := range []string{"patternProperties", "^[A-Za-z]+$", "properties", "rules", "items", "properties", "level"} {
+		rule = rule[pointer].(map[string]interface{})
+	}
+
+	levels := []string{}
+	for _, level := range rule["enum"].([]interface{}) {
+		levels = append(levels, level.(string))
+	}
+	sort.Strings(levels)
+
+	if want := []string{string(Error), string(Warning)}; !reflect.DeepEqual(levels, want) {
+		t.Errorf("level enum is %v, want %v", levels, want)
+	}
+}
+
+// Check the schema describes the Go type. Properties are matched to struct
+// fields by their JSON name.
+func checkSchemaType(t *testing.T, root map[string]interface{}, pointer string, typ reflect.Type, schema map[string]interface{}) {
+	schema = utils.ResolveSchemaRef(root, schema)
+	if schema == nil {
+		t.Errorf("%s: schema not found", pointer)
+		return
+	}
+
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	wantType := map[reflect.Kind]string{
+		reflect.String:  "string",
+		reflect.Bool:    "boolean",
+		reflect.Int:     "integer",
+		reflect.Float64: "number",
+		reflect.Slice:   "array",
+		reflect.Map:     "object",
+		reflect.Struct:  "object",
+	}[typ.Kind()]
+	if wantType == "" {
+		t.Errorf("%s: unsupported Go type %s", pointer, typ)
+		return
+	}
+	if got, _ := schema["type"].(string); got != wantType {
+		t.Errorf("%s: schema type is %q, want %q for %s", pointer, got, wantType, typ)
+		return
+	}
+
+	switch typ.Kind() {
+	case reflect.Slice:
+		items, ok := schema["items"].(map[string]interface{})
+		if !ok {
+			t.Errorf("%s: schema has no items", pointer)
+			return
+		}
+		checkSchemaType(t, root, pointer+"/items", typ.Elem(), items)
+
+	case reflect.Map:
+		values := []map[string]interface{}{}
+		if patterns, ok := schema["patternProperties"].(map[string]interface{}); ok {
+			for _, value := range patterns {
+				values = append(values, value.(map[string]interface{}))
+			}
+		}
+		if additional, ok := schema["additionalProperties"].(map[string]interface{}); ok {
+			values = append(values, additional)
+		}
+		if len(values) == 0 {
+			t.Errorf("%s: schema has no patternProperties or additionalProperties", pointer)
+		}
+		for _, value := range values {
+			checkSchemaType(t, root, pointer+"/*", typ.Elem(), value)
+		}
+
+	case reflect.Struct:
+		props, _ := schema["properties"].(map[string]interface{})
+		if additional, ok := schema["additionalProperties"].(bool); !ok || additional {
+			t.Errorf("%s: schema must set additionalProperties to false", pointer)
+		}
+
+		fields := map[string]bool{}
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			// Not part of the file, like Condition.Dir
+			if name == "-" {
+				continue
+			}
+			fields[name] = true
+
+			prop, ok := props[name].(map[string]interface{})
+			if !ok {
+				t.Errorf("%s: schema has no property %s for %s.%s", pointer, name, typ.Name(), field.Name)
+				continue
+			}
+			checkSchemaType(t, root, pointer+"/"+name, field.Type, prop)
+		}
+
+		for key := range props {
+			if !fields[key] {
+				t.Errorf("%s/%s: %s has no field for the schema property", pointer, key, typ.Name())
+			}
+		}
+	}
+}
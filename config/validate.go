@@ -0,0 +1,78 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/schemas"
+	"github.com/PrinceMerluza/devcenter-content-linter/utils"
+)
+
+// Validate the rule set file against the rule set JSON Schema. A
+// *utils.SyntaxError is returned if the file can't be parsed.
+func ValidateRuleSetFile(path string) ([]utils.SchemaViolation, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	isJson := strings.EqualFold(filepath.Ext(path), ".json")
+
+	return validateRuleSetData(data, isJson)
+}
+
+// Validate the rule set file against the rule set JSON Schema. Returns a
+// *ViolationsError listing the violations if it's invalid.
+func CheckRuleSetFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return checkRuleSetData(path, data)
+}
+
+func checkRuleSetData(path string, data []byte) error {
+	isJson := strings.EqualFold(filepath.Ext(path), ".json")
+
+	violations, err := validateRuleSetData(data, isJson)
+	if err != nil {
+		var syntaxErr *utils.SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			return err
+		}
+		violations = []utils.SchemaViolation{{Line: syntaxErr.Line, Message: syntaxErr.Error()}}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &ViolationsError{Path: path, Violations: violations}
+}
+
+func validateRuleSetData(data []byte, isJson bool) ([]utils.SchemaViolation, error) {
+	return utils.ValidateJsonSchema(schemas.RuleSetSchema, data, isJson)
+}
+
+// The rule set file doesn't match the rule set schema
+type ViolationsError struct {
+	Path       string
+	Violations []utils.SchemaViolation
+}
+
+func (e *ViolationsError) Error() string {
+	lines := []string{}
+	for _, violation := range e.Violations {
+		if violation.Pointer == "" {
+			lines = append(lines, fmt.Sprintf("%s:%d: %s", e.Path, violation.Line, violation.Message))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s:%d: %s: %s", e.Path, violation.Line, violation.Pointer, violation.Message))
+	}
+
+	return fmt.Sprintf("invalid rule set:\n%s", strings.Join(lines, "\n"))
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/utils"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidateRuleSetFile(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want []utils.SchemaViolation
+	}{
+		{
+			name: "Blueprint YAML Rule Set",
+			path: "../presets/blueprint.rule.yaml",
+			want: []utils.SchemaViolation{},
+		},
+		{
+			name: "Article Rule Set",
+			path: "../presets/article.rule.yaml",
+			want: []utils.SchemaViolation{},
+		},
+		{
+			name: "Blog Post Rule Set",
+			path: "../presets/blogpost.rule.yaml",
+			want: []utils.SchemaViolation{},
+		},
+		{
+			name: "Guide Rule Set",
+			path: "../presets/guide.rule.yaml",
+			want: []utils.SchemaViolation{},
+		},
+		{
+			name: "JSON Rule Set",
+			path: "test/valid.rule.json",
+			want: []utils.SchemaViolation{},
+		},
+		{
+			name: "Invalid Rule Set",
+			path: "test/invalid.rule.yaml",
+			want: []utils.SchemaViolation{
+				{
+					Pointer: "/ruleGroups/STRUCT/rules/0/conditions/0/pathExist",
+					Line:    9,
+					Message: "property \"pathExist\" is not allowed",
+				},
+				{
+					Pointer: "/ruleGroups/STRUCT/rules/1/path",
+					Line:    12,
+					Message: "property \"path\" is not allowed",
+				},
+				{
+					Pointer: "/ruleGroups/STRUCT/rules/1/conditions/0/checkReferenceExists",
+					Line:    14,
+					Message: "property \"checkReferenceExists\" is not allowed",
+				},
+				{
+					Pointer: "/ruleGroups/STRUCT/rules/1/level",
+					Line:    16,
+					Message: "should be one of [\"warning\", \"error\"]",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ValidateRuleSetFile(tt.path)
+			if err != nil {
+				t.Fatalf("Error: %v", err)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("%v", cmp.Diff(got, tt.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package linter
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
+	"github.com/PrinceMerluza/devcenter-content-linter/utils"
+)
+
+type AllowedEntriesCondition struct {
+	Path     string
+	Allowed  []string
+	compiled *compiledPatterns // set by the plan
+}
+
+func (condition *AllowedEntriesCondition) Validate(ctx context.Context) *ConditionResult {
+	ret := &ConditionResult{
+		FileHighlights: &[]FileHighlight{},
+		IsSuccess:      true,
+	}
+
+	res, err := condition.compiled.globList(condition.Allowed)
+	if err != nil {
+		ret.Error = err
+		ret.IsSuccess = false
+		return ret
+	}
+
+	err = utils.WalkRel(condition.Path, func(path string, relPath string, d fs.DirEntry) error {
+		if ctx.Err() != nil {
+			return canceledError(ctx)
+		}
+
+		for _, re := range res {
+			// Everything inside an allowed directory is allowed
+			if re.MatchString(relPath) {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		// Directories that may contain allowed entries are only parents
+		if d.IsDir() && globsCouldMatchUnder(condition.Allowed, relPath) {
+			return nil
+		}
+
+		ret.IsSuccess = false
+		*ret.FileHighlights = append(*ret.FileHighlights, FileHighlight{
+			Path:    blueprintrepo.GetRelPath(path),
+			Message: "path is not allowed here",
+		})
+
+		if d.IsDir() {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	if err != nil {
+		ret.Error = err
+		ret.IsSuccess = false
+	}
+
+	return ret
+}
+
+// Check if any of the glob patterns can match a path inside the directory.
+// Each segment of the directory is compared with the pattern's segment at the
+// same depth.
+func globsCouldMatchUnder(patterns []string, dir string) bool {
+	dirSegments := strings.Split(dir, "/")
+
+	for _, pattern := range patterns {
+		patternSegments := strings.Split(strings.TrimPrefix(filepath.ToSlash(pattern), "./"), "/")
+		couldMatch := len(patternSegments) > len(dirSegments)
+
+		for i := 0; i < len(dirSegments) && i < len(patternSegments); i++ {
+			if strings.Contains(patternSegments[i], "**") {
+				couldMatch = true
+				break
+			}
+
+			re, err := utils.CompileGlob(patternSegments[i])
+			if err != nil || !re.MatchString(dirSegments[i]) {
+				couldMatch = false
+				break
+			}
+		}
+
+		if couldMatch {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,98 @@
+package linter
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAllowedEntriesCondition_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *AllowedEntriesCondition
+		want      *ConditionResult
+	}{
+		{
+			name: "Everything Allowed",
+			condition: &AllowedEntriesCondition{
+				Path:    pathsBlueprintDir,
+				Allowed: []string{"*"},
+			},
+			want: &ConditionResult{
+				IsSuccess:      true,
+				FileHighlights: &[]FileHighlight{},
+			},
+		},
+		{
+			name: "Allowed Directory",
+			condition: &AllowedEntriesCondition{
+				Path:    pathsBlueprintDir,
+				Allowed: []string{"index.md", "images"},
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:    relPath(filepath.Join(pathsBlueprintDir, "drafts")),
+						Message: "path is not allowed here",
+					},
+					{
+						Path:    relPath(filepath.Join(pathsBlueprintDir, "notes.txt")),
+						Message: "path is not allowed here",
+					},
+				},
+			},
+		},
+		{
+			name: "Nested Patterns",
+			condition: &AllowedEntriesCondition{
+				Path:    pathsDir,
+				Allowed: []string{"README.md", ".env", "{build,node_modules}", "blueprint/index.md", "blueprint/images/*.png", "blueprint/drafts/**"},
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:    relPath(filepath.Join(pathsBlueprintDir, "notes.txt")),
+						Message: "path is not allowed here",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Validate(context.Background()); !cmp.Equal(got, tt.want) {
+				t.Errorf("%v", cmp.Diff(got, tt.want))
+				if got.Error != nil {
+					t.Errorf("Error: %v", got.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestAllowedEntriesCondition_ValidateWithErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *AllowedEntriesCondition
+	}{
+		{
+			name: "Non-existent Path",
+			condition: &AllowedEntriesCondition{
+				Path:    incorrectPath,
+				Allowed: []string{"index.md"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Validate(context.Background()); got.Error == nil {
+				t.Errorf("Expected error, got nil")
+			}
+		})
+	}
+}
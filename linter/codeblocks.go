@@ -0,0 +1,210 @@
+package linter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/logger"
+	"github.com/PrinceMerluza/devcenter-content-linter/utils"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"gopkg.in/yaml.v3"
+)
+
+var fenceRe = regexp.MustCompile("^([ \t]*)(`{3,}|~{3,})[ \t]*([^`]*)$")
+
+type CodeBlocksCondition struct {
+	Path       string
+	CodeBlocks *config.CodeBlocksCondition
+}
+
+// A fenced code block in a Markdown file
+type codeBlock struct {
+	Language  string
+	StartLine int // line of the opening fence
+	EndLine   int // line of the closing fence, or the last line if unclosed
+	Content   string
+}
+
+func (condition *CodeBlocksCondition) Validate(ctx context.Context) *ConditionResult {
+	ret := &ConditionResult{
+		FileHighlights: &[]FileHighlight{},
+		IsSuccess:      true,
+	}
+
+	logger.Tracef("Opening file %s \n", condition.Path)
+	fileData, err := os.ReadFile(condition.Path)
+	if err != nil {
+		ret.Error = err
+		ret.IsSuccess = false
+		return ret
+	}
+
+	allowed := map[string]bool{}
+	for _, lang := range condition.CodeBlocks.AllowedLanguages {
+		allowed[strings.ToLower(lang)] = true
+	}
+
+	lines := strings.Split(strings.ReplaceAll(string(fileData), "\r\n", "\n"), "\n")
+	for _, block := range findCodeBlocks(lines) {
+		if ctx.Err() != nil {
+			ret.Error = canceledError(ctx)
+			ret.IsSuccess = false
+			break
+		}
+
+		highlight := FileHighlight{
+			Path:        blueprintrepo.GetRelPath(condition.Path),
+			LineNumber:  block.StartLine,
+			LineCount:   block.EndLine - block.StartLine + 1,
+			LineContent: strings.TrimSpace(lines[block.StartLine-1]),
+		}
+
+		if block.Language == "" {
+			if condition.CodeBlocks.RequireLanguage {
+				highlight.Message = "code block has no language tag"
+				ret.IsSuccess = false
+				*ret.FileHighlights = append(*ret.FileHighlights, highlight)
+			}
+			continue
+		}
+
+		if len(allowed) > 0 && !allowed[block.Language] {
+			highlight.Message = fmt.Sprintf("language %q is not allowed", block.Language)
+			ret.IsSuccess = false
+			*ret.FileHighlights = append(*ret.FileHighlights, highlight)
+			continue
+		}
+
+		if !condition.CodeBlocks.ValidateSyntax {
+			continue
+		}
+
+		// Line numbers from the parsers are relative to the block's content,
+		// which starts on the line after the opening fence.
+		errLine, err := checkCodeSyntax(block.Language, block.Content, block.StartLine+1)
+		if err != nil {
+			if errLine < block.StartLine+1 || errLine > block.EndLine {
+				errLine = block.StartLine
+			}
+			ret.IsSuccess = false
+			*ret.FileHighlights = append(*ret.FileHighlights, FileHighlight{
+				Path:        blueprintrepo.GetRelPath(condition.Path),
+				LineNumber:  errLine,
+				LineCount:   1,
+				LineContent: strings.TrimSpace(lines[errLine-1]),
+				Message:     fmt.Sprintf("invalid %s: %s", block.Language, err.Error()),
+			})
+		}
+	}
+
+	return ret
+}
+
+// Find all fenced code blocks in the Markdown lines
+func findCodeBlocks(lines []string) []codeBlock {
+	blocks := []codeBlock{}
+
+	for i := 0; i < len(lines); i++ {
+		match := fenceRe.FindStringSubmatch(lines[i])
+		if match == nil {
+			continue
+		}
+
+		indent := len(match[1])
+		fence := match[2]
+		block := codeBlock{
+			StartLine: i + 1,
+			EndLine:   len(lines),
+		}
+		if info := strings.Fields(match[3]); len(info) > 0 {
+			block.Language = strings.ToLower(info[0])
+		}
+
+		content := []string{}
+		for i++; i < len(lines); i++ {
+			trimmed := strings.TrimSpace(lines[i])
+			if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+				block.EndLine = i + 1
+				break
+			}
+
+			// Remove the fence's indentation from the content
+			line := lines[i]
+			for n := 0; n < indent && len(line) > 0 && (line[0] == ' ' || line[0] == '\t'); n++ {
+				line = line[1:]
+			}
+			content = append(content, line)
+		}
+
+		block.Content = strings.Join(content, "\n")
+		blocks = append(blocks, block)
+	}
+
+	return blocks
+}
+
+// Parse the code in the given language. Returns the line in the file where the
+// syntax error is located. Languages that can't be checked always pass.
+func checkCodeSyntax(language string, code string, firstLine int) (int, error) {
+	switch language {
+	case "json":
+		var tmp interface{}
+		if err := json.Unmarshal([]byte(code), &tmp); err != nil {
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				return firstLine + utils.LineAtOffset(code, int(syntaxErr.Offset)) - 1, err
+			}
+			return firstLine, err
+		}
+	case "yaml", "yml":
+		decoder := yaml.NewDecoder(strings.NewReader(code))
+		for {
+			var node yaml.Node
+			err := decoder.Decode(&node)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return firstLine + utils.YamlErrorLine(err) - 1, err
+			}
+		}
+	case "xml":
+		decoder := xml.NewDecoder(bytes.NewReader([]byte(code)))
+		for {
+			_, err := decoder.Token()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				var syntaxErr *xml.SyntaxError
+				if errors.As(err, &syntaxErr) {
+					return firstLine + syntaxErr.Line - 1, err
+				}
+				return firstLine, err
+			}
+		}
+	case "hcl", "terraform", "tf":
+		_, diags := hclsyntax.ParseConfig([]byte(code), "", hcl.Pos{Line: firstLine, Column: 1})
+		if diags.HasErrors() {
+			for _, diag := range diags {
+				if diag.Severity == hcl.DiagError && diag.Subject != nil {
+					return diag.Subject.Start.Line, errors.New(diag.Summary + ": " + diag.Detail)
+				}
+			}
+			return firstLine, diags
+		}
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,149 @@
+package linter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCodeBlocksCondition_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *CodeBlocksCondition
+		want      *ConditionResult
+	}{
+		{
+			name: "Empty Markdown",
+			condition: &CodeBlocksCondition{
+				Path: emptyFile,
+				CodeBlocks: &config.CodeBlocksCondition{
+					RequireLanguage: true,
+					ValidateSyntax:  true,
+				},
+			},
+			want: &ConditionResult{
+				IsSuccess:      true,
+				FileHighlights: &[]FileHighlight{},
+			},
+		},
+		{
+			name: "Require Language",
+			condition: &CodeBlocksCondition{
+				Path: codeBlocksFile,
+				CodeBlocks: &config.CodeBlocksCondition{
+					RequireLanguage: true,
+				},
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:        relPath(codeBlocksFile),
+						LineNumber:  11,
+						LineCount:   3,
+						LineContent: "```",
+						Message:     "code block has no language tag",
+					},
+				},
+			},
+		},
+		{
+			name: "Allowed Languages",
+			condition: &CodeBlocksCondition{
+				Path: codeBlocksFile,
+				CodeBlocks: &config.CodeBlocksCondition{
+					AllowedLanguages: []string{"JSON", "yaml", "xml", "hcl", "terraform"},
+				},
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:        relPath(codeBlocksFile),
+						LineNumber:  45,
+						LineCount:   3,
+						LineContent: "```python",
+						Message:     "language \"python\" is not allowed",
+					},
+				},
+			},
+		},
+		{
+			name: "Validate Syntax",
+			condition: &CodeBlocksCondition{
+				Path: codeBlocksFile,
+				CodeBlocks: &config.CodeBlocksCondition{
+					ValidateSyntax: true,
+				},
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:        relPath(codeBlocksFile),
+						LineNumber:  18,
+						LineCount:   1,
+						LineContent: "- a",
+						Message:     "invalid yaml: yaml: line 3: did not find expected key",
+					},
+					{
+						Path:        relPath(codeBlocksFile),
+						LineNumber:  25,
+						LineCount:   1,
+						LineContent: "</roots>",
+						Message:     "invalid xml: XML syntax error on line 3: element <root> closed by </roots>",
+					},
+					{
+						Path:        relPath(codeBlocksFile),
+						LineNumber:  37,
+						LineCount:   1,
+						LineContent: "}",
+						Message:     "invalid json: invalid character '}' looking for beginning of object key string",
+					},
+					{
+						Path:        relPath(codeBlocksFile),
+						LineNumber:  41,
+						LineCount:   1,
+						LineContent: "provider \"genesyscloud\" {",
+						Message:     "invalid terraform: Unclosed configuration block: There is no closing brace for this block before the end of the file. This may be caused by incorrect brace nesting elsewhere in this file.",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Validate(context.Background()); !cmp.Equal(got, tt.want) {
+				t.Errorf("%v", cmp.Diff(got, tt.want))
+				if got.Error != nil {
+					t.Errorf("Error: %v", got.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestCodeBlocksCondition_ValidateWithErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *CodeBlocksCondition
+	}{
+		{
+			name: "Non-existent Path",
+			condition: &CodeBlocksCondition{
+				Path:       incorrectPath,
+				CodeBlocks: &config.CodeBlocksCondition{RequireLanguage: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Validate(context.Background()); got.Error == nil {
+				t.Errorf("Expected error, got nil")
+			}
+		})
+	}
+}
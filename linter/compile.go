@@ -0,0 +1,519 @@
+package linter
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/utils"
+)
+
+// A problem in the rule set which prevents it from being evaluated. The codes
+// are the same as the codes of the errors of evaluated rules.
+type CompileError struct {
+	Code      ErrorCode
+	RuleId    string // rule or rule group the error is in
+	Condition int    // index of the condition in the rule, -1 if not in a condition
+	Err       error
+}
+
+func (e *CompileError) Error() string {
+	location := e.RuleId
+	if e.Condition >= 0 {
+		location = fmt.Sprintf("%s: condition %d", e.RuleId, e.Condition)
+	}
+	if location == "" {
+		return fmt.Sprintf("%s: %v", e.Code, e.Err)
+	}
+
+	return fmt.Sprintf("%s: %s: %v", location, e.Code, e.Err)
+}
+
+func (e *CompileError) Unwrap() error {
+	return e.Err
+}
+
+// All the problems found while compiling a rule set
+type CompileErrors []*CompileError
+
+func (errs CompileErrors) Error() string {
+	messages := []string{}
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+
+	return fmt.Sprintf("invalid rule set:\n%s", strings.Join(messages, "\n"))
+}
+
+// A rule set which has been checked and is ready to be evaluated. A plan is
+// not modified after it's compiled so it can be shared between goroutines.
+type Plan struct {
+	name        string
+	description string
+	rules       []*plannedRule
+}
+
+type plannedRule struct {
+	id          string
+	description string
+	level       config.Level
+	file        *string // relative to the content path
+	conditions  []validatorFactory
+}
+
+// Create the validator of a condition for the rule's target path. The
+// validator gets the patterns compiled with the plan and deep copies of the
+// condition's values, so changes to the rule set don't affect the plan.
+type validatorFactory func(targetPath string) Validator
+
+// Compile the rule set into a plan. Regexes and globs are compiled and paths
+// referenced by the rule set are resolved relative to ruleSetPath. All the
+// problems are returned as CompileErrors.
+func Compile(ruleSet *config.RuleSet, ruleSetPath string) (*Plan, error) {
+	if ruleSet == nil {
+		return nil, errors.New("nil rule set")
+	}
+
+	c := &compiler{ruleSetDir: filepath.Dir(ruleSetPath)}
+	plan := &Plan{
+		name:        ruleSet.Name,
+		description: ruleSet.Description,
+		rules:       []*plannedRule{},
+	}
+
+	if ruleSet.RuleGroups == nil || len(*ruleSet.RuleGroups) == 0 {
+		c.addError(MissingValueCode, "", -1, errors.New("rule set has no rule groups"))
+		return nil, c.errs
+	}
+
+	// Keep the rule order stable
+	groupIds := []string{}
+	for id := range *ruleSet.RuleGroups {
+		groupIds = append(groupIds, id)
+	}
+	sort.Strings(groupIds)
+
+	for _, groupId := range groupIds {
+		group := (*ruleSet.RuleGroups)[groupId]
+		if group.Rules == nil || len(*group.Rules) == 0 {
+			c.addError(MissingValueCode, groupId, -1, errors.New("rule group has no rules"))
+			continue
+		}
+
+		for i, rule := range *group.Rules {
+			if rule.Disabled {
+				continue
+			}
+			if planned := c.compileRule(rule, config.RuleId(groupId, i)); planned != nil {
+				plan.rules = append(plan.rules, planned)
+			}
+		}
+	}
+
+	if len(c.errs) > 0 {
+		return nil, c.errs
+	}
+
+	return plan, nil
+}
+
+type compiler struct {
+	ruleSetDir string
+	errs       CompileErrors
+}
+
+func (c *compiler) addError(code ErrorCode, ruleId string, condition int, err error) {
+	c.errs = append(c.errs, &CompileError{
+		Code:      code,
+		RuleId:    ruleId,
+		Condition: condition,
+		Err:       err,
+	})
+}
+
+func (c *compiler) compileRule(rule config.Rule, ruleId string) *plannedRule {
+	errCount := len(c.errs)
+
+	switch rule.Level {
+	case config.Undefined, config.Warning, config.Error:
+	default:
+		c.addError(InvalidValueCode, ruleId, -1, fmt.Errorf("unknown level %s", rule.Level))
+	}
+
+	if rule.Conditions == nil || len(*rule.Conditions) == 0 {
+		c.addError(MissingValueCode, ruleId, -1, errors.New("rule has no conditions"))
+		return nil
+	}
+
+	planned := &plannedRule{
+		id:          ruleId,
+		description: rule.Description,
+		level:       rule.Level,
+		file:        copyString(rule.File),
+		conditions:  []validatorFactory{},
+	}
+	for i := range *rule.Conditions {
+		if factory := c.compileCondition(&(*rule.Conditions)[i], ruleId, i); factory != nil {
+			planned.conditions = append(planned.conditions, factory)
+		}
+	}
+
+	if len(c.errs) > errCount {
+		return nil
+	}
+
+	return planned
+}
+
+// Check the condition and get the factory of its validator. Exactly one type
+// of condition must be defined.
+func (c *compiler) compileCondition(condition *config.Condition, ruleId string, index int) validatorFactory {
+	keys := []string{}
+	var factory validatorFactory
+	errCount := len(c.errs)
+	addError := func(code ErrorCode, err error) {
+		c.addError(code, ruleId, index, err)
+	}
+	compiled := newCompiledPatterns()
+	checkGlobs := func(key string, patterns []string) {
+		for _, pattern := range patterns {
+			re, err := utils.CompileGlob(pattern)
+			if err != nil {
+				addError(InvalidGlobCode, fmt.Errorf("%s: %w", key, err))
+				continue
+			}
+			compiled.globs[pattern] = re
+		}
+	}
+	checkRegex := func(key string, pattern string) *regexp.Regexp {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			addError(InvalidRegexCode, fmt.Errorf("%s: %w", key, err))
+			return nil
+		}
+		compiled.regexes[pattern] = re
+		return re
+	}
+
+	// PathExists Condition
+	if condition.PathExists != nil {
+		keys = append(keys, "pathExists")
+		relPath := *condition.PathExists
+		factory = func(targetPath string) Validator {
+			return &PathExistsCondition{
+				Path: path.Join(targetPath, relPath),
+			}
+		}
+	}
+
+	// PathNotExists Condition
+	if condition.PathNotExists != nil {
+		keys = append(keys, "pathNotExists")
+		if len(*condition.PathNotExists) == 0 {
+			addError(MissingValueCode, errors.New("pathNotExists: no patterns"))
+		}
+		checkGlobs("pathNotExists", *condition.PathNotExists)
+		patterns := copyStrings(*condition.PathNotExists)
+		factory = func(targetPath string) Validator {
+			return &PathNotExistsCondition{
+				Path:     targetPath,
+				Patterns: &patterns,
+				compiled: compiled,
+			}
+		}
+	}
+
+	// AllowedEntries Condition
+	if condition.AllowedEntries != nil {
+		keys = append(keys, "allowedEntries")
+		checkGlobs("allowedEntries", condition.AllowedEntries.Allowed)
+		entriesPath := condition.AllowedEntries.Path
+		allowed := copyStrings(condition.AllowedEntries.Allowed)
+		factory = func(targetPath string) Validator {
+			return &AllowedEntriesCondition{
+				Path:     path.Join(targetPath, entriesPath),
+				Allowed:  allowed,
+				compiled: compiled,
+			}
+		}
+	}
+
+	// PathNaming Condition
+	if condition.PathNaming != nil {
+		keys = append(keys, "pathNaming")
+		naming := *condition.PathNaming
+		naming.Files = copyStrings(naming.Files)
+		if naming.Preset != "" {
+			if _, err := matchesNamingPreset(naming.Preset, ""); err != nil {
+				addError(InvalidValueCode, fmt.Errorf("pathNaming: %w", err))
+			}
+		}
+		if naming.Pattern != "" {
+			checkRegex("pathNaming", naming.Pattern)
+		}
+		if len(naming.Files) > 0 {
+			checkGlobs("pathNaming", naming.Files)
+		} else {
+			checkGlobs("pathNaming", defaultNamingFiles)
+		}
+		factory = func(targetPath string) Validator {
+			return &PathNamingCondition{
+				Path:       targetPath,
+				PathNaming: &naming,
+				compiled:   compiled,
+			}
+		}
+	}
+
+	// Contains Conditions
+	if condition.Contains != nil {
+		keys = append(keys, "contains")
+		for _, contains := range *condition.Contains {
+			if strings.TrimSpace(contains.Value) == "" {
+				addError(MissingValueCode, errors.New("contains: value is empty"))
+			}
+			switch contains.Type {
+			case "static":
+			case "regex":
+				checkRegex("contains", contains.Value)
+			default:
+				addError(InvalidValueCode, fmt.Errorf("contains: unknown type %s", contains.Type))
+			}
+		}
+		containsArr := append([]config.ContainsCondition{}, *condition.Contains...)
+		factory = func(targetPath string) Validator {
+			return &ContainsCondition{
+				Path:        targetPath,
+				ContainsArr: &containsArr,
+				compiled:    compiled,
+			}
+		}
+	}
+
+	// Not Contains Condition
+	if condition.NotContains != nil {
+		keys = append(keys, "notContains")
+		for _, pattern := range *condition.NotContains {
+			if strings.TrimSpace(pattern) == "" {
+				addError(MissingValueCode, errors.New("notContains: value is empty"))
+				continue
+			}
+			checkRegex("notContains", pattern)
+		}
+		notContains := copyStrings(*condition.NotContains)
+		factory = func(targetPath string) Validator {
+			return &NotContainsCondition{
+				Path:        targetPath,
+				NotContains: &notContains,
+				compiled:    compiled,
+			}
+		}
+	}
+
+	// Check reference Exist Condition
+	if condition.CheckReferenceExist != nil {
+		keys = append(keys, "checkReferenceExist")
+		for _, pattern := range *condition.CheckReferenceExist {
+			// The first matching group is the referenced path
+			if re := checkRegex("checkReferenceExist", pattern); re != nil && re.NumSubexp() < 1 {
+				addError(NoCaptureGroupCode, fmt.Errorf("checkReferenceExist: %s has no matching group for the path", pattern))
+			}
+		}
+		patterns := copyStrings(*condition.CheckReferenceExist)
+		factory = func(targetPath string) Validator {
+			return &RefExistsCondition{
+				Path:              targetPath,
+				ReferencePatterns: &patterns,
+				compiled:          compiled,
+			}
+		}
+	}
+
+	// JSON Schema Condition
+	if condition.JsonSchema != nil {
+		keys = append(keys, "jsonSchema")
+		dir := c.ruleSetDir
+		if condition.Dir != "" {
+			dir = condition.Dir
+		}
+		schemaPath := resolveRuleSetPath(dir, *condition.JsonSchema)
+		if _, err := os.Stat(schemaPath); err != nil {
+			addError(FileNotFoundCode, fmt.Errorf("jsonSchema: %w", err))
+		}
+		factory = func(targetPath string) Validator {
+			return &JsonSchemaCondition{
+				Path:       targetPath,
+				SchemaPath: schemaPath,
+			}
+		}
+	}
+
+	// Code Blocks Condition
+	if condition.CodeBlocks != nil {
+		keys = append(keys, "codeBlocks")
+		codeBlocks := *condition.CodeBlocks
+		codeBlocks.AllowedLanguages = copyStrings(codeBlocks.AllowedLanguages)
+		factory = func(targetPath string) Validator {
+			return &CodeBlocksCondition{
+				Path:       targetPath,
+				CodeBlocks: &codeBlocks,
+			}
+		}
+	}
+
+	// Text Hygiene Condition
+	if condition.TextHygiene != nil {
+		keys = append(keys, "textHygiene")
+		switch condition.TextHygiene.LineEndings {
+		case "", "lf", "crlf":
+		default:
+			addError(InvalidValueCode, fmt.Errorf("textHygiene: unknown line endings %s", condition.TextHygiene.LineEndings))
+		}
+		checkGlobs("textHygiene", condition.TextHygiene.Files)
+		textHygiene := *condition.TextHygiene
+		textHygiene.Files = copyStrings(textHygiene.Files)
+		factory = func(targetPath string) Validator {
+			return &TextHygieneCondition{
+				Path:        targetPath,
+				TextHygiene: &textHygiene,
+				compiled:    compiled,
+			}
+		}
+	}
+
+	// Secrets Condition
+	if condition.Secrets != nil {
+		keys = append(keys, "secrets")
+		if _, err := enabledSecretDetectors(condition.Secrets.Detectors); err != nil {
+			addError(InvalidValueCode, fmt.Errorf("secrets: %w", err))
+		}
+		for _, patterns := range condition.Secrets.Allowlist {
+			for _, pattern := range patterns {
+				checkRegex("secrets", pattern)
+			}
+		}
+		checkGlobs("secrets", condition.Secrets.Files)
+		secrets := *condition.Secrets
+		secrets.Files = copyStrings(secrets.Files)
+		secrets.Detectors = copyStrings(secrets.Detectors)
+		if secrets.Allowlist != nil {
+			secrets.Allowlist = map[string][]string{}
+			for name, patterns := range condition.Secrets.Allowlist {
+				secrets.Allowlist[name] = copyStrings(patterns)
+			}
+		}
+		factory = func(targetPath string) Validator {
+			return &SecretsCondition{
+				Path:     targetPath,
+				Secrets:  &secrets,
+				compiled: compiled,
+			}
+		}
+	}
+
+	// Terraform Condition
+	if condition.Terraform != nil {
+		keys = append(keys, "terraform")
+		if len(condition.Terraform.Files) > 0 {
+			checkGlobs("terraform", condition.Terraform.Files)
+		} else {
+			checkGlobs("terraform", defaultTerraformFiles)
+		}
+		terraform := *condition.Terraform
+		terraform.Files = copyStrings(terraform.Files)
+		terraform.PinnedProviders = copyStrings(terraform.PinnedProviders)
+		terraform.NoHardcodedAttributes = copyStrings(terraform.NoHardcodedAttributes)
+		factory = func(targetPath string) Validator {
+			return &TerraformCondition{
+				Path:      targetPath,
+				Terraform: &terraform,
+				compiled:  compiled,
+			}
+		}
+	}
+
+	switch {
+	case len(keys) == 0:
+		addError(EmptyConditionCode, errors.New("condition has no known type"))
+	case len(keys) > 1:
+		addError(ConflictingConditionCode, fmt.Errorf("condition has more than one type: %s", strings.Join(keys, ", ")))
+	}
+
+	if len(c.errs) > errCount {
+		return nil
+	}
+
+	return factory
+}
+
+func copyString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	ret := *s
+	return &ret
+}
+
+func copyStrings(s []string) []string {
+	if s == nil {
+		return nil
+	}
+	return append([]string{}, s...)
+}
+
+// Regexes and globs of a condition by pattern, compiled with the plan.
+// Conditions which weren't compiled with a plan have none, so their patterns
+// are compiled when they're validated.
+type compiledPatterns struct {
+	regexes map[string]*regexp.Regexp
+	globs   map[string]*regexp.Regexp
+}
+
+func newCompiledPatterns() *compiledPatterns {
+	return &compiledPatterns{
+		regexes: map[string]*regexp.Regexp{},
+		globs:   map[string]*regexp.Regexp{},
+	}
+}
+
+// Get the compiled regex of the pattern
+func (p *compiledPatterns) regex(pattern string) (*regexp.Regexp, error) {
+	if p != nil {
+		if re, ok := p.regexes[pattern]; ok {
+			return re, nil
+		}
+	}
+
+	return regexp.Compile(pattern)
+}
+
+// Get the compiled glob of the pattern
+func (p *compiledPatterns) glob(pattern string) (*regexp.Regexp, error) {
+	if p != nil {
+		if re, ok := p.globs[pattern]; ok {
+			return re, nil
+		}
+	}
+
+	return utils.CompileGlob(pattern)
+}
+
+// Get the compiled globs of the patterns, in the same order
+func (p *compiledPatterns) globList(patterns []string) ([]*regexp.Regexp, error) {
+	ret := []*regexp.Regexp{}
+	for _, pattern := range patterns {
+		re, err := p.glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, re)
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,187 @@
+package linter
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/presets"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestCompile(t *testing.T) {
+	str := func(s string) *string {
+		return &s
+	}
+	ruleSet := func(rules ...config.Rule) *config.RuleSet {
+		return &config.RuleSet{
+			Name: "Test",
+			RuleGroups: &map[string]config.RuleGroup{
+				"TEST": {Rules: &rules},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		ruleSet *config.RuleSet
+		want    []ErrorCode
+	}{
+		{
+			name: "Valid Rule Set",
+			ruleSet: ruleSet(
+				config.Rule{Conditions: &[]config.Condition{{PathExists: str("README.md")}}, Level: config.Error},
+				config.Rule{Conditions: &[]config.Condition{{NotContains: &[]string{"TODO"}}}, Level: config.Warning},
+				config.Rule{Conditions: &[]config.Condition{{JsonSchema: str(integrationSchema)}}},
+				// From an extended rule set in another directory
+				config.Rule{Conditions: &[]config.Condition{{JsonSchema: str("./integration.schema.json"), Dir: "test/jsonschema"}}},
+			),
+			want: nil,
+		},
+		{
+			name:    "No Rule Groups",
+			ruleSet: &config.RuleSet{Name: "Test"},
+			want:    []ErrorCode{MissingValueCode},
+		},
+		{
+			name:    "No Rules",
+			ruleSet: ruleSet(),
+			want:    []ErrorCode{MissingValueCode},
+		},
+		{
+			name: "No Conditions",
+			ruleSet: ruleSet(
+				config.Rule{Level: config.Error},
+			),
+			want: []ErrorCode{MissingValueCode},
+		},
+		{
+			name: "Empty and Conflicting Conditions",
+			ruleSet: ruleSet(
+				config.Rule{Conditions: &[]config.Condition{
+					{},
+					{PathExists: str("README.md"), NotContains: &[]string{"TODO"}},
+				}},
+			),
+			want: []ErrorCode{EmptyConditionCode, ConflictingConditionCode},
+		},
+		{
+			name: "Invalid Values",
+			ruleSet: ruleSet(
+				config.Rule{Conditions: &[]config.Condition{{NotContains: &[]string{"(unclosed"}}}},
+				config.Rule{Conditions: &[]config.Condition{{CheckReferenceExist: &[]string{`\[.*\]`}}}},
+				config.Rule{Conditions: &[]config.Condition{{PathNotExists: &[]string{"{a,b"}}}},
+				config.Rule{Conditions: &[]config.Condition{{JsonSchema: str("./aasifGJASDIOOJ123LKRJAWSLIEUWE.json")}}},
+				config.Rule{Conditions: &[]config.Condition{{Secrets: &config.SecretsCondition{Detectors: []string{"unknown"}}}}},
+				config.Rule{Conditions: &[]config.Condition{{PathExists: str("README.md")}}, Level: "fatal"},
+			),
+			want: []ErrorCode{InvalidRegexCode, NoCaptureGroupCode, InvalidGlobCode, FileNotFoundCode, InvalidValueCode, InvalidValueCode},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := Compile(tt.ruleSet, "./rule.yaml")
+
+			var got []ErrorCode
+			var compileErrs CompileErrors
+			if errors.As(err, &compileErrs) {
+				for _, compileErr := range compileErrs {
+					got = append(got, compileErr.Code)
+				}
+			} else if err != nil {
+				t.Fatalf("Error: %v", err)
+			}
+
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("%v", cmp.Diff(got, tt.want))
+			}
+			if (plan == nil) == (err == nil) {
+				t.Errorf("plan is %v with error %v", plan, err)
+			}
+		})
+	}
+}
+
+// The built-in rule sets must compile without errors
+func TestCompile_Presets(t *testing.T) {
+	for _, preset := range presets.Names() {
+		t.Run(preset, func(t *testing.T) {
+			ruleSet, err := config.ResolvePreset(preset)
+			if err != nil {
+				t.Fatalf("Error: %v", err)
+			}
+			if _, err := Compile(ruleSet, ""); err != nil {
+				t.Errorf("Error: %v", err)
+			}
+		})
+	}
+}
+
+// Changing the rule set after it's compiled must not change the plan
+func TestCompile_Copies(t *testing.T) {
+	str := func(s string) *string {
+		return &s
+	}
+	notContains := []string{"Link Example"}
+	rules := []config.Rule{
+		{File: str("notcontains.md"), Conditions: &[]config.Condition{{NotContains: &notContains}}},
+		{File: str("codeblocks.md"), Conditions: &[]config.Condition{{CodeBlocks: &config.CodeBlocksCondition{AllowedLanguages: []string{"json"}}}}},
+		{Conditions: &[]config.Condition{{PathNaming: &config.PathNamingCondition{Files: []string{"naming/**"}, Preset: "kebab-case"}}}},
+		{Conditions: &[]config.Condition{{TextHygiene: &config.TextHygieneCondition{Files: []string{"texthygiene/*.md"}, NoTrailingWhitespace: true}}}},
+		{Conditions: &[]config.Condition{{Secrets: &config.SecretsCondition{
+			Files:     []string{"secrets/*"},
+			Detectors: []string{"aws-access-key", "private-key"},
+			Allowlist: map[string][]string{"*": {"EXAMPLE$"}},
+		}}}},
+		{File: str("terraform/bad"), Conditions: &[]config.Condition{{Terraform: &config.TerraformCondition{
+			Files:                 []string{"*.tf"},
+			PinnedProviders:       []string{"mypurecloud/genesyscloud"},
+			NoHardcodedAttributes: []string{"oauthclient_secret"},
+		}}}},
+	}
+	ruleSet := &config.RuleSet{
+		Name:       "Test",
+		RuleGroups: &map[string]config.RuleGroup{"test": {Rules: &rules}},
+	}
+
+	plan, err := Compile(ruleSet, "")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	run := func() []RuleResult {
+		result := plan.Run("./test", DefaultRuleTimeout)
+		ret := append(*result.SuccessResults, *result.FailureResults...)
+		sort.Slice(ret, func(i, j int) bool {
+			return ret[i].Id < ret[j].Id
+		})
+		return ret
+	}
+	want := run()
+
+	*rules[0].File = "missing.md"
+	notContains[0] = "(unclosed"
+	conditions := func(i int) config.Condition {
+		return (*rules[i].Conditions)[0]
+	}
+	conditions(1).CodeBlocks.AllowedLanguages[0] = "yaml"
+	conditions(2).PathNaming.Files[0] = "none/**"
+	conditions(3).TextHygiene.Files[0] = "none/*"
+	conditions(4).Secrets.Files[0] = "none/*"
+	conditions(4).Secrets.Detectors[0] = "unknown"
+	conditions(4).Secrets.Allowlist["*"][0] = ".*"
+	conditions(5).Terraform.Files[0] = "none/*"
+	conditions(5).Terraform.PinnedProviders[0] = "hashicorp/aws"
+	conditions(5).Terraform.NoHardcodedAttributes[0] = "name"
+
+	got := run()
+	if !cmp.Equal(got, want, cmpopts.IgnoreFields(RuleResult{}, "Duration")) {
+		t.Errorf("%v", cmp.Diff(got, want, cmpopts.IgnoreFields(RuleResult{}, "Duration")))
+	}
+	for _, result := range want {
+		if result.Error != nil {
+			t.Errorf("%s: %v", result.Id, result.Error)
+		}
+	}
+}
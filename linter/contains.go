@@ -1,9 +1,10 @@
 package linter
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"os"
-	"regexp"
 	"strings"
 
 	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
@@ -15,9 +16,10 @@ import (
 type ContainsCondition struct {
 	Path        string
 	ContainsArr *[]config.ContainsCondition
+	compiled    *compiledPatterns // set by the plan
 }
 
-func (condition *ContainsCondition) Validate() *ConditionResult {
+func (condition *ContainsCondition) Validate(ctx context.Context) *ConditionResult {
 	ret := &ConditionResult{
 		FileHighlights: &[]FileHighlight{},
 		IsSuccess:      true,
@@ -34,8 +36,14 @@ func (condition *ContainsCondition) Validate() *ConditionResult {
 	dataString := string(fileData[:])
 
 	for _, contains := range *condition.ContainsArr {
+		if ctx.Err() != nil {
+			ret.Error = canceledError(ctx)
+			ret.IsSuccess = false
+			break
+		}
+
 		if strings.TrimSpace(contains.Value) == "" {
-			ret.Error = errors.New("value of contains is empty")
+			ret.Error = conditionError(InvalidValueCode, ErrorContext{}, errors.New("value of contains is empty"))
 			ret.IsSuccess = false
 			break
 		}
@@ -53,7 +61,10 @@ func (condition *ContainsCondition) Validate() *ConditionResult {
 
 			lineContent, err := utils.GetStringAtLine(dataString, lineNumber)
 			if err != nil {
-				ret.Error = err
+				ret.Error = conditionError(ReadErrorCode, ErrorContext{
+					Path: blueprintrepo.GetRelPath(condition.Path),
+					Line: lineNumber,
+				}, err)
 				ret.IsSuccess = false
 				break
 			}
@@ -65,7 +76,7 @@ func (condition *ContainsCondition) Validate() *ConditionResult {
 				LineCount:   1,
 			})
 		case "regex":
-			re, err := regexp.Compile(contains.Value)
+			re, err := condition.compiled.regex(contains.Value)
 			if err != nil {
 				ret.Error = err
 				ret.IsSuccess = false
@@ -89,7 +100,7 @@ func (condition *ContainsCondition) Validate() *ConditionResult {
 				LineCount:   lineCount,
 			})
 		default:
-			ret.Error = errors.New("unknown contains type")
+			ret.Error = conditionError(InvalidValueCode, ErrorContext{}, fmt.Errorf("unknown contains type %s", contains.Type))
 			ret.IsSuccess = false
 		}
 	}
@@ -1,6 +1,7 @@
 package linter
 
 import (
+	"context"
 	"testing"
 
 	"github.com/PrinceMerluza/devcenter-content-linter/config"
@@ -89,7 +90,7 @@ func TestContainsCondition_Validate(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.condition.Validate(); !cmp.Equal(got, tt.want) {
+			if got := tt.condition.Validate(context.Background()); !cmp.Equal(got, tt.want) {
 				t.Errorf("%v", cmp.Diff(got, tt.want))
 				if got.Error != nil {
 					t.Errorf("Error: %v", got.Error)
@@ -136,7 +137,7 @@ func TestContainsCondition_ValidateWithErrors(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.condition.Validate(); got.Error == nil {
+			if got := tt.condition.Validate(context.Background()); got.Error == nil {
 				t.Errorf("Expected error, got nil")
 			}
 		})
@@ -0,0 +1,141 @@
+package linter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+)
+
+// Describe the condition in readable form. Each check of the condition is a
+// separate sentence.
+func DescribeCondition(condition *config.Condition) []string {
+	ret := []string{}
+
+	if condition.PathExists != nil {
+		ret = append(ret, fmt.Sprintf("%s must exist", *condition.PathExists))
+	}
+
+	if condition.PathNotExists != nil {
+		ret = append(ret, fmt.Sprintf("No path may match %s", joinQuoted(*condition.PathNotExists)))
+	}
+
+	if entries := condition.AllowedEntries; entries != nil {
+		ret = append(ret, fmt.Sprintf("%s may only contain paths matching %s", entries.Path, joinQuoted(entries.Allowed)))
+	}
+
+	if naming := condition.PathNaming; naming != nil {
+		target := "Names of all paths"
+		if len(naming.Files) > 0 {
+			target = fmt.Sprintf("Names of paths matching %s", joinQuoted(naming.Files))
+		}
+		if naming.Preset != "" {
+			ret = append(ret, fmt.Sprintf("%s must be %s", target, naming.Preset))
+		}
+		if naming.Pattern != "" {
+			ret = append(ret, fmt.Sprintf("%s must match the regex `%s`", target, naming.Pattern))
+		}
+		if naming.MaxLength > 0 {
+			ret = append(ret, fmt.Sprintf("%s must be at most %d characters long", target, naming.MaxLength))
+		}
+		if naming.CaseCollisions {
+			ret = append(ret, "No two paths may differ only in case")
+		}
+	}
+
+	if condition.Contains != nil {
+		for _, contains := range *condition.Contains {
+			if contains.Type == "regex" {
+				ret = append(ret, fmt.Sprintf("Must contain a match of the regex `%s`", contains.Value))
+				continue
+			}
+			ret = append(ret, fmt.Sprintf("Must contain the text %q", contains.Value))
+		}
+	}
+
+	if condition.NotContains != nil {
+		for _, pattern := range *condition.NotContains {
+			ret = append(ret, fmt.Sprintf("No line may match the regex `%s`", pattern))
+		}
+	}
+
+	if condition.CheckReferenceExist != nil {
+		for _, pattern := range *condition.CheckReferenceExist {
+			ret = append(ret, fmt.Sprintf("Paths referenced by the first group of the regex `%s` must exist", pattern))
+		}
+	}
+
+	if condition.JsonSchema != nil {
+		ret = append(ret, fmt.Sprintf("Must be valid against the JSON Schema %s", *condition.JsonSchema))
+	}
+
+	if codeBlocks := condition.CodeBlocks; codeBlocks != nil {
+		if codeBlocks.RequireLanguage {
+			ret = append(ret, "Fenced code blocks must have a language tag")
+		}
+		if len(codeBlocks.AllowedLanguages) > 0 {
+			ret = append(ret, fmt.Sprintf("Code block languages must be one of %s", joinQuoted(codeBlocks.AllowedLanguages)))
+		}
+		if codeBlocks.ValidateSyntax {
+			ret = append(ret, "JSON, YAML, XML and HCL code blocks must be valid")
+		}
+	}
+
+	if hygiene := condition.TextHygiene; hygiene != nil {
+		if hygiene.Utf8 {
+			ret = append(ret, "Files must be valid UTF-8")
+		}
+		if hygiene.NoBom {
+			ret = append(ret, "Files must not start with a byte order mark")
+		}
+		if hygiene.LineEndings != "" {
+			ret = append(ret, fmt.Sprintf("Line endings must be %s", strings.ToUpper(hygiene.LineEndings)))
+		}
+		if hygiene.NoTrailingWhitespace {
+			ret = append(ret, "Lines must not end with whitespace")
+		}
+		if hygiene.MaxLineLength > 0 {
+			ret = append(ret, fmt.Sprintf("Lines must be at most %d characters long", hygiene.MaxLineLength))
+		}
+		if hygiene.NoFrontMatterTabs {
+			ret = append(ret, "The front matter must not contain tabs")
+		}
+		if hygiene.NoSuspiciousUnicode {
+			ret = append(ret, "No invisible characters, or smart quotes in code")
+		}
+	}
+
+	if secrets := condition.Secrets; secrets != nil {
+		detectors := "any built-in detector"
+		if len(secrets.Detectors) > 0 {
+			detectors = joinQuoted(secrets.Detectors)
+		}
+		ret = append(ret, fmt.Sprintf("Must not contain credentials found by %s", detectors))
+		if secrets.MinEntropy > 0 {
+			ret = append(ret, fmt.Sprintf("Must not contain strings with an entropy of %v bits or more", secrets.MinEntropy))
+		}
+	}
+
+	if terraform := condition.Terraform; terraform != nil {
+		if len(terraform.PinnedProviders) > 0 {
+			ret = append(ret, fmt.Sprintf("Providers %s must have a version constraint", joinQuoted(terraform.PinnedProviders)))
+		}
+		if len(terraform.NoHardcodedAttributes) > 0 {
+			ret = append(ret, fmt.Sprintf("Attributes %s must not be hard-coded", joinQuoted(terraform.NoHardcodedAttributes)))
+		}
+		if len(terraform.PinnedProviders) == 0 && len(terraform.NoHardcodedAttributes) == 0 {
+			ret = append(ret, "Terraform files must be valid")
+		}
+	}
+
+	return ret
+}
+
+func joinQuoted(values []string) string {
+	quoted := []string{}
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,60 @@
+package linter
+
+import (
+	"testing"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDescribeCondition(t *testing.T) {
+	str := func(s string) *string {
+		return &s
+	}
+
+	tests := []struct {
+		name      string
+		condition *config.Condition
+		want      []string
+	}{
+		{
+			name:      "Path Exists",
+			condition: &config.Condition{PathExists: str("./README.md")},
+			want:      []string{"./README.md must exist"},
+		},
+		{
+			name: "Contains",
+			condition: &config.Condition{Contains: &[]config.ContainsCondition{
+				{Type: "static", Value: "WALDO"},
+				{Type: "regex", Value: "## *Scenario"},
+			}},
+			want: []string{
+				"Must contain the text \"WALDO\"",
+				"Must contain a match of the regex `## *Scenario`",
+			},
+		},
+		{
+			name: "Text Hygiene",
+			condition: &config.Condition{TextHygiene: &config.TextHygieneCondition{
+				LineEndings:   "lf",
+				MaxLineLength: 120,
+			}},
+			want: []string{
+				"Line endings must be LF",
+				"Lines must be at most 120 characters long",
+			},
+		},
+		{
+			name:      "Empty Condition",
+			condition: &config.Condition{},
+			want:      []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DescribeCondition(tt.condition); !cmp.Equal(got, tt.want) {
+				t.Errorf("%v", cmp.Diff(got, tt.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package linter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"regexp/syntax"
+)
+
+// Stable code of an error which prevents a rule from being evaluated, or the
+// rule set from being compiled
+type ErrorCode string
+
+const (
+	FileNotFoundCode         ErrorCode = "file-not-found"
+	InvalidRegexCode         ErrorCode = "invalid-regex"
+	InvalidGlobCode          ErrorCode = "invalid-glob"
+	ReadErrorCode            ErrorCode = "read-error"
+	NoCaptureGroupCode       ErrorCode = "no-capture-group"
+	TimeoutCode              ErrorCode = "timeout"
+	MissingValueCode         ErrorCode = "missing-value" // a required value of the rule set is missing or empty
+	InvalidValueCode         ErrorCode = "invalid-value" // a value of the condition can't be used, like an unknown type
+	EmptyConditionCode       ErrorCode = "empty-condition"
+	ConflictingConditionCode ErrorCode = "conflicting-condition" // a condition with more than one type
+	UnknownErrorCode         ErrorCode = "unknown"
+)
+
+// Where an error happened. Empty fields are unknown.
+type ErrorContext struct {
+	Path    string `json:"path,omitempty"` // relative to the content path
+	Line    int    `json:"line,omitempty"`
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// An error of a condition with a code, for errors the code can't be derived
+// from
+type ConditionError struct {
+	Code    ErrorCode
+	Context ErrorContext
+	Err     error
+}
+
+func (e *ConditionError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ConditionError) Unwrap() error {
+	return e.Err
+}
+
+func conditionError(code ErrorCode, context ErrorContext, err error) error {
+	return &ConditionError{Code: code, Context: context, Err: err}
+}
+
+// Error of a condition which stopped because its rule timed out
+func canceledError(ctx context.Context) error {
+	return conditionError(TimeoutCode, ErrorContext{}, ctx.Err())
+}
+
+// An error which prevented a rule from being evaluated, as opposed to content
+// which fails the rule
+type ValidationError struct {
+	RuleId  string
+	Code    ErrorCode
+	Context ErrorContext
+	Err     error
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Code, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// The JSON of the error. The message is the message of the underlying error,
+// without the code.
+func (e *ValidationError) MarshalJSON() ([]byte, error) {
+	type jsonError struct {
+		RuleId  string        `json:"ruleId"`
+		Code    ErrorCode     `json:"code"`
+		Message string        `json:"message"`
+		Context *ErrorContext `json:"context,omitempty"`
+	}
+
+	ret := jsonError{
+		RuleId: e.RuleId,
+		Code:   e.Code,
+	}
+	if e.Err != nil {
+		ret.Message = e.Err.Error()
+	}
+	if e.Context != (ErrorContext{}) {
+		context := e.Context
+		ret.Context = &context
+	}
+
+	return json.Marshal(ret)
+}
+
+// Get the error of the rule with its code. The code is the one of a
+// ConditionError in the chain, else it's derived from the error: missing
+// files, other file errors and invalid regexes. Paths are made relative to
+// the content path.
+func newValidationError(ruleId string, contentPath string, err error) *ValidationError {
+	ret := &ValidationError{
+		RuleId: ruleId,
+		Code:   UnknownErrorCode,
+		Err:    err,
+	}
+
+	var conditionErr *ConditionError
+	var pathErr *fs.PathError
+	var syntaxErr *syntax.Error
+	switch {
+	case errors.As(err, &conditionErr):
+		ret.Code = conditionErr.Code
+		ret.Context = conditionErr.Context
+	case errors.As(err, &syntaxErr):
+		ret.Code = InvalidRegexCode
+		ret.Context.Pattern = syntaxErr.Expr
+	case errors.As(err, &pathErr):
+		ret.Code = ReadErrorCode
+		if errors.Is(err, fs.ErrNotExist) {
+			ret.Code = FileNotFoundCode
+		}
+		ret.Context.Path = filepath.ToSlash(pathErr.Path)
+		if relPath, err := filepath.Rel(contentPath, pathErr.Path); err == nil {
+			ret.Context.Path = filepath.ToSlash(relPath)
+		}
+	}
+
+	return ret
+}
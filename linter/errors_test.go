@@ -0,0 +1,148 @@
+package linter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewValidationError(t *testing.T) {
+	_, notFoundErr := os.Open(filepath.Join("test", "missing.md"))
+	_, dirErr := os.ReadFile(filepath.Join("test", "paths"))
+	_, regexErr := regexp.Compile("(a")
+
+	tests := []struct {
+		name        string
+		err         error
+		wantCode    ErrorCode
+		wantContext ErrorContext
+	}{
+		{
+			name:        "Missing file",
+			err:         notFoundErr,
+			wantCode:    FileNotFoundCode,
+			wantContext: ErrorContext{Path: "missing.md"},
+		},
+		{
+			name:        "Wrapped missing file",
+			err:         fmt.Errorf("can't load schema: %w", notFoundErr),
+			wantCode:    FileNotFoundCode,
+			wantContext: ErrorContext{Path: "missing.md"},
+		},
+		{
+			name:        "Directory",
+			err:         dirErr,
+			wantCode:    ReadErrorCode,
+			wantContext: ErrorContext{Path: "paths"},
+		},
+		{
+			name:        "Invalid regex",
+			err:         regexErr,
+			wantCode:    InvalidRegexCode,
+			wantContext: ErrorContext{Pattern: "(a"},
+		},
+		{
+			name:        "Condition error",
+			err:         conditionError(NoCaptureGroupCode, ErrorContext{Path: "index.md", Line: 3, Pattern: "a"}, errors.New("no matching group")),
+			wantCode:    NoCaptureGroupCode,
+			wantContext: ErrorContext{Path: "index.md", Line: 3, Pattern: "a"},
+		},
+		{
+			name:     "Other error",
+			err:      errors.New("something"),
+			wantCode: UnknownErrorCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newValidationError("link_0", "test", tt.err)
+			if got.Code != tt.wantCode || !cmp.Equal(got.Context, tt.wantContext) || got.Err != tt.err {
+				t.Errorf("got %s %+v, want %s %+v", got.Code, got.Context, tt.wantCode, tt.wantContext)
+			}
+		})
+	}
+}
+
+func TestValidationError_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *ValidationError
+		want string
+	}{
+		{
+			name: "With context",
+			err:  &ValidationError{RuleId: "link_0", Code: ReadErrorCode, Context: ErrorContext{Path: "index.md", Line: 4}, Err: errors.New("line number out of range")},
+			want: `{"ruleId":"link_0","code":"read-error","message":"line number out of range","context":{"path":"index.md","line":4}}`,
+		},
+		{
+			name: "Without context",
+			err:  &ValidationError{RuleId: "link_0", Code: UnknownErrorCode, Err: errors.New("something")},
+			want: `{"ruleId":"link_0","code":"unknown","message":"something"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.err)
+			if err != nil {
+				t.Fatalf("Error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+type slowValidator struct {
+	validated *int32
+	finished  *int32
+}
+
+// Works through 10 files like a condition, stopping when ctx is done
+func (v *slowValidator) Validate(ctx context.Context) *ConditionResult {
+	atomic.AddInt32(v.validated, 1)
+	for i := 0; i < 10; i++ {
+		if ctx.Err() != nil {
+			return &ConditionResult{Error: canceledError(ctx)}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	atomic.AddInt32(v.finished, 1)
+	return &ConditionResult{IsSuccess: true}
+}
+
+func TestValidateRuleWithTimeout(t *testing.T) {
+	var validated, finished int32
+	slow := func(targetPath string) Validator {
+		return &slowValidator{validated: &validated, finished: &finished}
+	}
+	rule := &plannedRule{
+		id:         "slow_0",
+		conditions: []validatorFactory{slow, slow},
+	}
+
+	got := validateRuleWithTimeout(rule, "test", 10*time.Millisecond)
+	if got.IsSuccess || got.Error == nil || got.Error.Code != TimeoutCode {
+		t.Errorf("got %+v, want a timeout error", got)
+	}
+
+	// The running condition stops, the next one is skipped
+	time.Sleep(300 * time.Millisecond)
+	if n := atomic.LoadInt32(&validated); n != 1 {
+		t.Errorf("%d conditions validated after the timeout, want 1", n)
+	}
+	if n := atomic.LoadInt32(&finished); n != 0 {
+		t.Errorf("%d conditions finished after the timeout, want 0", n)
+	}
+}
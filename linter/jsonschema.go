@@ -0,0 +1,80 @@
+package linter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
+	"github.com/PrinceMerluza/devcenter-content-linter/logger"
+	"github.com/PrinceMerluza/devcenter-content-linter/utils"
+)
+
+type JsonSchemaCondition struct {
+	Path       string
+	SchemaPath string
+}
+
+func (condition *JsonSchemaCondition) Validate(ctx context.Context) *ConditionResult {
+	ret := &ConditionResult{
+		FileHighlights: &[]FileHighlight{},
+		IsSuccess:      true,
+	}
+
+	logger.Tracef("Opening schema %s \n", condition.SchemaPath)
+	schemaData, err := utils.LoadAsJson(condition.SchemaPath)
+	if err != nil {
+		ret.Error = fmt.Errorf("can't load schema: %w", err)
+		ret.IsSuccess = false
+		return ret
+	}
+
+	logger.Tracef("Opening file %s \n", condition.Path)
+	fileData, err := os.ReadFile(condition.Path)
+	if err != nil {
+		ret.Error = err
+		ret.IsSuccess = false
+		return ret
+	}
+	dataString := string(fileData)
+
+	isJson := strings.EqualFold(filepath.Ext(condition.Path), ".json")
+	violations, err := utils.ValidateJsonSchema(schemaData, fileData, isJson)
+	if err != nil {
+		var syntaxErr *utils.SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			ret.Error = err
+			ret.IsSuccess = false
+			return ret
+		}
+
+		// Unparseable files are a content failure, not a rule error
+		violations = []utils.SchemaViolation{{
+			Line:    syntaxErr.Line,
+			Message: syntaxErr.Error(),
+		}}
+	}
+
+	for _, violation := range violations {
+		ret.IsSuccess = false
+
+		message := violation.Message
+		if violation.Pointer != "" {
+			message = fmt.Sprintf("%s: %s", violation.Pointer, violation.Message)
+		}
+		lineContent, _ := utils.GetStringAtLine(dataString, violation.Line)
+
+		*ret.FileHighlights = append(*ret.FileHighlights, FileHighlight{
+			Path:        blueprintrepo.GetRelPath(condition.Path),
+			LineNumber:  violation.Line,
+			LineContent: strings.TrimSpace(lineContent),
+			LineCount:   1,
+			Message:     message,
+		})
+	}
+
+	return ret
+}
@@ -0,0 +1,158 @@
+package linter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestJsonSchemaCondition_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *JsonSchemaCondition
+		want      *ConditionResult
+	}{
+		{
+			name: "Valid JSON",
+			condition: &JsonSchemaCondition{
+				Path:       validIntegration,
+				SchemaPath: integrationSchema,
+			},
+			want: &ConditionResult{
+				IsSuccess:      true,
+				FileHighlights: &[]FileHighlight{},
+			},
+		},
+		{
+			name: "Invalid JSON",
+			condition: &JsonSchemaCondition{
+				Path:       invalidIntegration,
+				SchemaPath: integrationSchema,
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:        relPath(invalidIntegration),
+						LineNumber:  1,
+						LineCount:   1,
+						LineContent: "{",
+						Message:     "/: \"name\" value is required",
+					},
+					{
+						Path:        relPath(invalidIntegration),
+						LineNumber:  2,
+						LineCount:   1,
+						LineContent: "\"integrationType\": \"unknown\",",
+						Message:     "/integrationType: should be one of [\"premium-app\", \"embedded-client-app\"]",
+					},
+					{
+						Path:        relPath(invalidIntegration),
+						LineNumber:  4,
+						LineCount:   1,
+						LineContent: "\"url\": \"http://example.com\"",
+						Message:     "/properties/url: regexp pattern ^https:// mismatch on string: http://example.com",
+					},
+				},
+			},
+		},
+		{
+			name: "Invalid YAML",
+			condition: &JsonSchemaCondition{
+				Path:       invalidIntegrationYml,
+				SchemaPath: integrationSchema,
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:        relPath(invalidIntegrationYml),
+						LineNumber:  4,
+						LineCount:   1,
+						LineContent: "url: ftp://example.com",
+						Message:     "/properties/url: regexp pattern ^https:// mismatch on string: ftp://example.com",
+					},
+				},
+			},
+		},
+		{
+			name: "Malformed JSON",
+			condition: &JsonSchemaCondition{
+				Path:       malformedIntegration,
+				SchemaPath: integrationSchema,
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:        relPath(malformedIntegration),
+						LineNumber:  4,
+						LineCount:   1,
+						LineContent: "}",
+						Message:     "invalid character '}' looking for beginning of value",
+					},
+				},
+			},
+		},
+		{
+			name: "Empty YAML",
+			condition: &JsonSchemaCondition{
+				Path:       emptyIntegrationYml,
+				SchemaPath: integrationSchema,
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:        relPath(emptyIntegrationYml),
+						LineNumber:  1,
+						LineCount:   1,
+						LineContent: "# The integration is added later",
+						Message:     "/: type should be object, got null",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Validate(context.Background()); !cmp.Equal(got, tt.want) {
+				t.Errorf("%v", cmp.Diff(got, tt.want))
+				if got.Error != nil {
+					t.Errorf("Error: %v", got.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestJsonSchemaCondition_ValidateWithErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *JsonSchemaCondition
+	}{
+		{
+			name: "Non-existent Schema",
+			condition: &JsonSchemaCondition{
+				Path:       validIntegration,
+				SchemaPath: incorrectPath,
+			},
+		},
+		{
+			name: "Non-existent Path",
+			condition: &JsonSchemaCondition{
+				Path:       incorrectPath,
+				SchemaPath: integrationSchema,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Validate(context.Background()); got.Error == nil {
+				t.Errorf("Expected error, got nil")
+			}
+		})
+	}
+}
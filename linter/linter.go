@@ -1,33 +1,51 @@
 package linter
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
+	"time"
 
 	"github.com/PrinceMerluza/devcenter-content-linter/config"
 )
 
+// Time a rule may take to evaluate if the validation has no timeout
+const DefaultRuleTimeout = 2 * time.Minute
+
 type ValidationData struct {
 	RuleSetName string
 	Description string
 	ContentPath string
+	RuleSetPath string
 	RuleData    *config.RuleSet
+	Plan        *Plan         // the compiled RuleData, compiled on validation if nil
+	RuleTimeout time.Duration // rules which take longer have a timeout error, DefaultRuleTimeout if zero
 }
 
 type ValidationResult struct {
-	SuccessResults *[]RuleResult `json:"success"`
-	FailureResults *[]RuleResult `json:"failed"`
+	SuccessResults *[]RuleResult   `json:"success"`
+	FailureResults *[]RuleResult   `json:"failed"`
+	Metadata       *ResultMetadata `json:"metadata,omitempty"`
+	StartTime      time.Time       `json:"-"`
+	Duration       time.Duration   `json:"-"` // time to evaluate all the rules
+}
+
+// Information about how the content was validated
+type ResultMetadata struct {
+	RuleSet *config.RuleSetSelection `json:"ruleSet,omitempty"`
 }
 
 type RuleResult struct {
 	Id             string           `json:"id"`
 	Level          config.Level     `json:"level"`
 	Description    string           `json:"description"`
-	IsSuccess      bool             `json:"-"`
+	IsSuccess      bool             `json:"isSuccess"`
 	FileHighlights *[]FileHighlight `json:"fileHighlights,omitempty"`
 	Error          *ValidationError `json:"error,omitempty"`
+	Duration       time.Duration    `json:"-"` // time to evaluate the rule
 }
 
 type ConditionResult struct {
@@ -41,44 +59,63 @@ type FileHighlight struct {
 	LineNumber  int    `json:"lineNumber"`
 	LineCount   int    `json:"lineCount"`
 	LineContent string `json:"lineContent"`
-}
-
-type ValidationError struct {
-	RuleId string
-	Err    error
+	Column      int    `json:"column,omitempty"`
+	EndColumn   int    `json:"endColumn,omitempty"` // column after the highlighted text, on the last line
+	Message     string `json:"message,omitempty"`
+	Sensitive   bool   `json:"sensitive,omitempty"` // the file around the line may hold secrets, so only the line content may be shown
 }
 
 type Validator interface {
-	Validate() *ConditionResult
+	Validate(ctx context.Context) *ConditionResult
 }
 
-// Validate the content
+// Validate the content. The rule set is compiled first if there's no plan, so
+// problems in it are reported before any rule is evaluated.
 func (input *ValidationData) Validate() (*ValidationResult, error) {
 	if input == nil {
 		return nil, errors.New("nil validation data")
 	}
 
-	rulesCount := 0
-	contentPath := input.ContentPath
-	ruleData := input.RuleData
+	if _, err := os.Stat(input.ContentPath); os.IsNotExist(err) {
+		return nil, err
+	}
+
+	plan := input.Plan
+	if plan == nil {
+		compiled, err := Compile(input.RuleData, input.RuleSetPath)
+		if err != nil {
+			return nil, err
+		}
+		plan = compiled
+	}
+
+	ruleTimeout := input.RuleTimeout
+	if ruleTimeout <= 0 {
+		ruleTimeout = DefaultRuleTimeout
+	}
+
+	return plan.Run(input.ContentPath, ruleTimeout), nil
+}
+
+// Evaluate the rules of the plan against the content. contentPath is the root
+// of content files. Rules which take longer than ruleTimeout have a timeout
+// error.
+func (plan *Plan) Run(contentPath string, ruleTimeout time.Duration) *ValidationResult {
 	finalResult := &ValidationResult{
 		SuccessResults: &[]RuleResult{},
 		FailureResults: &[]RuleResult{},
+		StartTime:      time.Now(),
 	}
 	ch := make(chan *RuleResult)
 
-	if _, err := os.Stat(contentPath); os.IsNotExist(err) {
-		return nil, err
-	}
-
-	for id, ruleGroup := range *ruleData.RuleGroups {
-		rulesCount += len(*ruleGroup.Rules)
-		if err := validateRuleGroup(&ruleGroup, ch, id, contentPath); err != nil {
-			return finalResult, err
-		}
+	for _, rule := range plan.rules {
+		rule := rule
+		go func() {
+			ch <- validateRuleWithTimeout(rule, contentPath, ruleTimeout)
+		}()
 	}
 
-	for i := 0; i < rulesCount; i++ {
+	for i := 0; i < len(plan.rules); i++ {
 		ruleResult := <-ch
 
 		if ruleResult.IsSuccess {
@@ -90,59 +127,79 @@ func (input *ValidationData) Validate() (*ValidationResult, error) {
 			continue
 		}
 	}
+	finalResult.Duration = time.Since(finalResult.StartTime)
 
-	return finalResult, nil
+	return finalResult
 }
 
-// Evaluate the rulegroup. Channel should be passed where the RuleResults will
-// be sent to.
-func validateRuleGroup(ruleGroup *config.RuleGroup, ch chan *RuleResult, groupId string, path string) error {
-	if ch == nil {
-		return fmt.Errorf("%s: channel is missing", groupId)
-	}
-
-	if len(groupId) <= 0 {
-		return fmt.Errorf("%s: group id is blank", groupId)
-	}
-
-	if len(path) <= 0 {
-		return fmt.Errorf("%s: path is blank", groupId)
-	}
-
-	for id, rule := range *ruleGroup.Rules {
-		ruleIdFull := fmt.Sprintf("%s_%v", groupId, id)
-		ruleCpy := rule
-
-		go func() {
-			ch <- validateRule(&ruleCpy, ruleIdFull, path)
-		}()
+// Evaluate the rule like validateRule. If it takes longer than the timeout
+// the rule isn't evaluated and has a timeout error. The condition which was
+// running stops at its next file or line, and the later ones are skipped.
+func validateRuleWithTimeout(rule *plannedRule, contentPath string, timeout time.Duration) *RuleResult {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	done := make(chan *RuleResult, 1)
+	go func() {
+		done <- validateRule(ctx, rule, contentPath)
+	}()
+
+	select {
+	case result := <-done:
+		return result
+	case <-ctx.Done():
+		return &RuleResult{
+			Id:          rule.id,
+			Level:       rule.level,
+			Description: rule.description,
+			Duration:    timeout,
+			Error: &ValidationError{
+				RuleId: rule.id,
+				Code:   TimeoutCode,
+				Err:    fmt.Errorf("rule took longer than %v", timeout),
+			},
+		}
 	}
-
-	return nil
 }
 
 // Evaluate the specific rule and get the RuleResult. Path is the root of
-// content files
-func validateRule(rule *config.Rule, ruleId string, contentPath string) *RuleResult {
+// content files. Conditions stop, and the remaining ones are skipped, once
+// ctx is done.
+func validateRule(ctx context.Context, rule *plannedRule, contentPath string) *RuleResult {
 	ret := &RuleResult{
-		Id:          ruleId,
-		Level:       rule.Level,
-		Description: rule.Description,
+		Id:          rule.id,
+		Level:       rule.level,
+		Description: rule.description,
 	}
+	start := time.Now()
+	defer func() {
+		ret.Duration = time.Since(start)
+	}()
 
 	targetPath := ""
-	if rule.File != nil {
-		tmpPath := path.Join(contentPath, *rule.File)
+	if rule.file != nil {
+		tmpPath := path.Join(contentPath, *rule.file)
 		targetPath = tmpPath
 	} else {
 		targetPath = contentPath
 	}
 
-	for _, condition := range *rule.Conditions {
-		condResult := validateCondition(&condition, targetPath)
+	for _, newValidator := range rule.conditions {
+		if ctx.Err() != nil {
+			ret.IsSuccess = false
+			ret.Error = &ValidationError{
+				RuleId: rule.id,
+				Code:   TimeoutCode,
+				Err:    ctx.Err(),
+			}
+			break
+		}
+
+		condResult := newValidator(targetPath).Validate(ctx)
 		if condResult == nil {
 			ret.Error = &ValidationError{
-				RuleId: ruleId,
+				RuleId: rule.id,
+				Code:   UnknownErrorCode,
 				Err:    errors.New("unexpected error. No result from condition"),
 			}
 			break
@@ -152,10 +209,7 @@ func validateRule(rule *config.Rule, ruleId string, contentPath string) *RuleRes
 		ret.FileHighlights = condResult.FileHighlights
 
 		if condResult.Error != nil {
-			ret.Error = &ValidationError{
-				RuleId: ruleId,
-				Err:    condResult.Error,
-			}
+			ret.Error = newValidationError(rule.id, contentPath, condResult.Error)
 		}
 
 		// Short circuit failing conditions
@@ -167,42 +221,12 @@ func validateRule(rule *config.Rule, ruleId string, contentPath string) *RuleRes
 	return ret
 }
 
-// Evaluate the condition. Any failure in any type of condition will short circuit the evaluation.
-func validateCondition(condition *config.Condition, targetPath string) *ConditionResult {
-	var ret *ConditionResult
-	var validator Validator
-
-	// PathExists Condition
-	if condition.PathExists != nil {
-		validator = &PathExistsCondition{
-			Path: path.Join(targetPath, *condition.PathExists),
-		}
-	}
-
-	// Contains Conditions
-	if condition.Contains != nil {
-		validator = &ContainsCondition{
-			Path:        targetPath,
-			ContainsArr: condition.Contains,
-		}
-	}
-
-	// Not Contains Condition
-	if condition.NotContains != nil {
-		validator = &NotContainsCondition{
-			Path:        targetPath,
-			NotContains: condition.NotContains,
-		}
-	}
-
-	// Check reference Exist Condition
-	if condition.CheckReferenceExist != nil {
-		validator = &RefExistsCondition{
-			Path:              targetPath,
-			ReferencePatterns: condition.CheckReferenceExist,
-		}
+// Resolve a path referenced from the rule set. Relative paths are relative to
+// the rule set file.
+func resolveRuleSetPath(ruleSetDir string, refPath string) string {
+	if filepath.IsAbs(refPath) {
+		return refPath
 	}
 
-	ret = validator.Validate()
-	return ret
+	return filepath.Join(ruleSetDir, refPath)
 }
@@ -2,10 +2,11 @@ package linter
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"os"
-	"regexp"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
 )
@@ -13,9 +14,10 @@ import (
 type NotContainsCondition struct {
 	Path        string
 	NotContains *[]string
+	compiled    *compiledPatterns // set by the plan
 }
 
-func (condition *NotContainsCondition) Validate() *ConditionResult {
+func (condition *NotContainsCondition) Validate(ctx context.Context) *ConditionResult {
 	ret := &ConditionResult{
 		FileHighlights: &[]FileHighlight{},
 	}
@@ -31,31 +33,39 @@ func (condition *NotContainsCondition) Validate() *ConditionResult {
 
 	for _, contains := range *condition.NotContains {
 		if strings.TrimSpace(contains) == "" {
-			ret.Error = errors.New("value of notcontains is empty")
+			ret.Error = conditionError(InvalidValueCode, ErrorContext{}, errors.New("value of notcontains is empty"))
 			ret.IsSuccess = false
 			break
 		}
 
+		re, err := condition.compiled.regex(contains)
+		if err != nil {
+			ret.Error = err
+			ret.IsSuccess = false
+			return ret
+		}
+
 		scanner := bufio.NewScanner(file)
 		lineNumber := 0
 		for scanner.Scan() {
-			lineNumber++
-			lineString := scanner.Text()
-
-			matched, err := regexp.MatchString(contains, lineString)
-			if err != nil {
-				ret.Error = err
+			if ctx.Err() != nil {
+				ret.Error = canceledError(ctx)
 				ret.IsSuccess = false
 				return ret
 			}
 
-			if matched {
+			lineNumber++
+			lineString := scanner.Text()
+
+			if match := re.FindStringIndex(lineString); match != nil {
 				ret.IsSuccess = false
 				*ret.FileHighlights = append(*ret.FileHighlights, FileHighlight{
 					Path:        blueprintrepo.GetRelPath(condition.Path),
 					LineNumber:  lineNumber,
 					LineContent: lineString,
 					LineCount:   1,
+					Column:      utf8.RuneCountInString(lineString[:match[0]]) + 1,
+					EndColumn:   utf8.RuneCountInString(lineString[:match[1]]) + 1,
 				})
 			}
 		}
@@ -1,6 +1,7 @@
 package linter
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -38,6 +39,8 @@ func TestNotContainsCondition_Validate(t *testing.T) {
 						LineNumber:  5,
 						LineCount:   1,
 						LineContent: "bbbbb [Link Example](b/b)",
+						Column:      7,
+						EndColumn:   26,
 					},
 				},
 			},
@@ -56,7 +59,7 @@ func TestNotContainsCondition_Validate(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.condition.Validate(); !cmp.Equal(got, tt.want) {
+			if got := tt.condition.Validate(context.Background()); !cmp.Equal(got, tt.want) {
 				t.Errorf("%v", cmp.Diff(got, tt.want))
 				if got.Error != nil {
 					t.Errorf("Error: %v", got.Error)
@@ -89,7 +92,7 @@ func TestNotContainsCondition_ValidateWithErrors(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.condition.Validate(); got.Error == nil {
+			if got := tt.condition.Validate(context.Background()); got.Error == nil {
 				t.Errorf("Expected error, got nil")
 			}
 		})
@@ -1,6 +1,7 @@
 package linter
 
 import (
+	"context"
 	"os"
 
 	"github.com/PrinceMerluza/devcenter-content-linter/logger"
@@ -10,7 +11,7 @@ type PathExistsCondition struct {
 	Path string
 }
 
-func (condition *PathExistsCondition) Validate() *ConditionResult {
+func (condition *PathExistsCondition) Validate(ctx context.Context) *ConditionResult {
 	ret := &ConditionResult{}
 	ret.IsSuccess = true
 
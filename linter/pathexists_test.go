@@ -1,6 +1,7 @@
 package linter
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -42,7 +43,7 @@ func TestPathExistsCondition_Validate(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.condition.Validate(); !cmp.Equal(got, tt.want) {
+			if got := tt.condition.Validate(context.Background()); !cmp.Equal(got, tt.want) {
 				t.Errorf("%v", cmp.Diff(got, tt.want))
 				if got.Error != nil {
 					t.Errorf("Error: %v", got.Error)
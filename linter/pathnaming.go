@@ -0,0 +1,150 @@
+package linter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/utils"
+)
+
+var defaultNamingFiles = []string{"**"}
+
+var kebabCaseRe = regexp.MustCompile(`^\.?[a-z0-9]+(-[a-z0-9]+)*(\.[a-z0-9]+(-[a-z0-9]+)*)*$`)
+
+type PathNamingCondition struct {
+	Path       string
+	PathNaming *config.PathNamingCondition
+	compiled   *compiledPatterns // set by the plan
+}
+
+func (condition *PathNamingCondition) Validate(ctx context.Context) *ConditionResult {
+	ret := &ConditionResult{
+		FileHighlights: &[]FileHighlight{},
+		IsSuccess:      true,
+	}
+	naming := condition.PathNaming
+
+	if _, err := os.Stat(condition.Path); err != nil {
+		ret.Error = err
+		ret.IsSuccess = false
+		return ret
+	}
+
+	var re *regexp.Regexp
+	if naming.Pattern != "" {
+		compiled, err := condition.compiled.regex(naming.Pattern)
+		if err != nil {
+			ret.Error = err
+			ret.IsSuccess = false
+			return ret
+		}
+		re = compiled
+	}
+
+	patterns := naming.Files
+	if len(patterns) == 0 {
+		patterns = defaultNamingFiles
+	}
+	globs, err := condition.compiled.globList(patterns)
+	if err != nil {
+		ret.Error = err
+		ret.IsSuccess = false
+		return ret
+	}
+	paths, err := utils.Glob(condition.Path, globs)
+	if err != nil {
+		ret.Error = err
+		ret.IsSuccess = false
+		return ret
+	}
+
+	addHighlight := func(path string, message string) {
+		ret.IsSuccess = false
+		*ret.FileHighlights = append(*ret.FileHighlights, FileHighlight{
+			Path:    blueprintrepo.GetRelPath(path),
+			Message: message,
+		})
+	}
+
+	for _, path := range paths {
+		if ctx.Err() != nil {
+			ret.Error = canceledError(ctx)
+			ret.IsSuccess = false
+			return ret
+		}
+
+		name := filepath.Base(path)
+
+		if naming.Preset != "" {
+			ok, err := matchesNamingPreset(naming.Preset, name)
+			if err != nil {
+				ret.Error = err
+				ret.IsSuccess = false
+				return ret
+			}
+			if !ok {
+				addHighlight(path, fmt.Sprintf("name %q is not %s", name, naming.Preset))
+			}
+		}
+
+		if re != nil && !re.MatchString(name) {
+			addHighlight(path, fmt.Sprintf("name %q does not match %s", name, naming.Pattern))
+		}
+
+		if length := utf8.RuneCountInString(name); naming.MaxLength > 0 && length > naming.MaxLength {
+			addHighlight(path, fmt.Sprintf("name is %d characters long, maximum is %d", length, naming.MaxLength))
+		}
+	}
+
+	if naming.CaseCollisions {
+		for _, collision := range findCaseCollisions(paths) {
+			addHighlight(collision[0], fmt.Sprintf("path differs only in case from %s", blueprintrepo.GetRelPath(collision[1])))
+		}
+	}
+
+	return ret
+}
+
+// Check the name against one of the naming presets
+func matchesNamingPreset(preset string, name string) (bool, error) {
+	switch preset {
+	case "kebab-case":
+		return kebabCaseRe.MatchString(name), nil
+	case "lowercase":
+		return name == strings.ToLower(name), nil
+	case "no-spaces":
+		return strings.IndexFunc(name, unicode.IsSpace) < 0, nil
+	}
+
+	return false, conditionError(InvalidValueCode, ErrorContext{}, fmt.Errorf("unknown naming preset %s", preset))
+}
+
+// Find the paths which collide on case-insensitive filesystems. Returns pairs
+// of the colliding path and the first path it collides with.
+func findCaseCollisions(paths []string) [][2]string {
+	sorted := make([]string, len(paths))
+	copy(sorted, paths)
+	sort.Strings(sorted)
+
+	first := map[string]string{}
+	collisions := [][2]string{}
+	for _, path := range sorted {
+		key := strings.ToLower(path)
+		if other, ok := first[key]; ok {
+			collisions = append(collisions, [2]string{path, other})
+			continue
+		}
+		first[key] = path
+	}
+
+	return collisions
+}
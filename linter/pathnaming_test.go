@@ -0,0 +1,155 @@
+package linter
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPathNamingCondition_Validate(t *testing.T) {
+	screenshot := relPath(filepath.Join(namingDir, "images", "Screen Shot 2022-03-01 at 4.15.png"))
+	flowDiagram := relPath(filepath.Join(namingDir, "images", "flow_diagram.PNG"))
+
+	tests := []struct {
+		name      string
+		condition *PathNamingCondition
+		want      *ConditionResult
+	}{
+		{
+			name: "No Spaces in Index",
+			condition: &PathNamingCondition{
+				Path: namingDir,
+				PathNaming: &config.PathNamingCondition{
+					Files:  []string{"*.md"},
+					Preset: "no-spaces",
+				},
+			},
+			want: &ConditionResult{
+				IsSuccess:      true,
+				FileHighlights: &[]FileHighlight{},
+			},
+		},
+		{
+			name: "Kebab Case",
+			condition: &PathNamingCondition{
+				Path: namingDir,
+				PathNaming: &config.PathNamingCondition{
+					Preset: "kebab-case",
+				},
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:    relPath(filepath.Join(namingDir, "Old Images")),
+						Message: "name \"Old Images\" is not kebab-case",
+					},
+					{
+						Path:    screenshot,
+						Message: "name \"Screen Shot 2022-03-01 at 4.15.png\" is not kebab-case",
+					},
+					{
+						Path:    flowDiagram,
+						Message: "name \"flow_diagram.PNG\" is not kebab-case",
+					},
+				},
+			},
+		},
+		{
+			name: "Pattern and Max Length",
+			condition: &PathNamingCondition{
+				Path: namingDir,
+				PathNaming: &config.PathNamingCondition{
+					Files:     []string{"images/*"},
+					Pattern:   `^[a-z0-9-]+\.(png|svg)$`,
+					MaxLength: 20,
+				},
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:    screenshot,
+						Message: "name \"Screen Shot 2022-03-01 at 4.15.png\" does not match ^[a-z0-9-]+\\.(png|svg)$",
+					},
+					{
+						Path:    screenshot,
+						Message: "name is 34 characters long, maximum is 20",
+					},
+					{
+						Path:    flowDiagram,
+						Message: "name \"flow_diagram.PNG\" does not match ^[a-z0-9-]+\\.(png|svg)$",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Validate(context.Background()); !cmp.Equal(got, tt.want) {
+				t.Errorf("%v", cmp.Diff(got, tt.want))
+				if got.Error != nil {
+					t.Errorf("Error: %v", got.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestPathNamingCondition_ValidateWithErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *PathNamingCondition
+	}{
+		{
+			name: "Non-existent Path",
+			condition: &PathNamingCondition{
+				Path:       incorrectPath,
+				PathNaming: &config.PathNamingCondition{Preset: "lowercase"},
+			},
+		},
+		{
+			name: "Unknown Preset",
+			condition: &PathNamingCondition{
+				Path:       namingDir,
+				PathNaming: &config.PathNamingCondition{Preset: "camelCase"},
+			},
+		},
+		{
+			name: "Invalid Pattern",
+			condition: &PathNamingCondition{
+				Path:       namingDir,
+				PathNaming: &config.PathNamingCondition{Pattern: "(["},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Validate(context.Background()); got.Error == nil {
+				t.Errorf("Expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestFindCaseCollisions(t *testing.T) {
+	paths := []string{
+		"blueprint/images/overview.png",
+		"blueprint/images/Overview.png",
+		"blueprint/index.md",
+		"Blueprint",
+		"blueprint",
+	}
+	want := [][2]string{
+		{"blueprint", "Blueprint"},
+		{"blueprint/images/overview.png", "blueprint/images/Overview.png"},
+	}
+
+	if got := findCaseCollisions(paths); !cmp.Equal(got, want) {
+		t.Errorf("%v", cmp.Diff(got, want))
+	}
+}
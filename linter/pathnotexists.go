@@ -0,0 +1,64 @@
+package linter
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
+	"github.com/PrinceMerluza/devcenter-content-linter/logger"
+	"github.com/PrinceMerluza/devcenter-content-linter/utils"
+)
+
+type PathNotExistsCondition struct {
+	Path     string
+	Patterns *[]string
+	compiled *compiledPatterns // set by the plan
+}
+
+func (condition *PathNotExistsCondition) Validate(ctx context.Context) *ConditionResult {
+	ret := &ConditionResult{
+		FileHighlights: &[]FileHighlight{},
+		IsSuccess:      true,
+	}
+
+	res, err := condition.compiled.globList(*condition.Patterns)
+	if err != nil {
+		ret.Error = err
+		ret.IsSuccess = false
+		return ret
+	}
+
+	err = utils.WalkRel(condition.Path, func(path string, relPath string, d fs.DirEntry) error {
+		if ctx.Err() != nil {
+			return canceledError(ctx)
+		}
+
+		for _, re := range res {
+			if !re.MatchString(relPath) {
+				continue
+			}
+
+			logger.Tracef("Path %s should not exist \n", path)
+			ret.IsSuccess = false
+			*ret.FileHighlights = append(*ret.FileHighlights, FileHighlight{
+				Path:    blueprintrepo.GetRelPath(path),
+				Message: "path must not exist",
+			})
+
+			// No need to report everything inside a forbidden directory
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			break
+		}
+
+		return nil
+	})
+	if err != nil {
+		ret.Error = err
+		ret.IsSuccess = false
+	}
+
+	return ret
+}
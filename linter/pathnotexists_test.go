@@ -0,0 +1,93 @@
+package linter
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPathNotExistsCondition_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *PathNotExistsCondition
+		want      *ConditionResult
+	}{
+		{
+			name: "Non-Existent Paths",
+			condition: &PathNotExistsCondition{
+				Path:     pathsDir,
+				Patterns: &[]string{"**/.DS_Store", "dist"},
+			},
+			want: &ConditionResult{
+				IsSuccess:      true,
+				FileHighlights: &[]FileHighlight{},
+			},
+		},
+		{
+			name: "Forbidden Paths",
+			condition: &PathNotExistsCondition{
+				Path:     pathsDir,
+				Patterns: &[]string{"**/node_modules", "**/.env", "build"},
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:    relPath(filepath.Join(pathsDir, ".env")),
+						Message: "path must not exist",
+					},
+					{
+						Path:    relPath(filepath.Join(pathsDir, "build")),
+						Message: "path must not exist",
+					},
+					{
+						Path:    relPath(filepath.Join(pathsDir, "node_modules")),
+						Message: "path must not exist",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Validate(context.Background()); !cmp.Equal(got, tt.want) {
+				t.Errorf("%v", cmp.Diff(got, tt.want))
+				if got.Error != nil {
+					t.Errorf("Error: %v", got.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestPathNotExistsCondition_ValidateWithErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *PathNotExistsCondition
+	}{
+		{
+			name: "Non-existent Path",
+			condition: &PathNotExistsCondition{
+				Path:     incorrectPath,
+				Patterns: &[]string{"**/.env"},
+			},
+		},
+		{
+			name: "Invalid Glob",
+			condition: &PathNotExistsCondition{
+				Path:     pathsDir,
+				Patterns: &[]string{"[z-a]"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Validate(context.Background()); got.Error == nil {
+				t.Errorf("Expected error, got nil")
+			}
+		})
+	}
+}
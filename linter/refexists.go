@@ -2,10 +2,10 @@ package linter
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"os"
 	"path/filepath"
-	"regexp"
 
 	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
 	"github.com/PrinceMerluza/devcenter-content-linter/logger"
@@ -14,9 +14,10 @@ import (
 type RefExistsCondition struct {
 	Path              string
 	ReferencePatterns *[]string
+	compiled          *compiledPatterns // set by the plan
 }
 
-func (condition *RefExistsCondition) Validate() *ConditionResult {
+func (condition *RefExistsCondition) Validate(ctx context.Context) *ConditionResult {
 	ret := &ConditionResult{
 		FileHighlights: &[]FileHighlight{},
 	}
@@ -31,7 +32,7 @@ func (condition *RefExistsCondition) Validate() *ConditionResult {
 	defer file.Close()
 
 	for _, pattern := range *condition.ReferencePatterns {
-		re, err := regexp.Compile(pattern)
+		re, err := condition.compiled.regex(pattern)
 		if err != nil {
 			ret.Error = err
 			ret.IsSuccess = false
@@ -41,6 +42,12 @@ func (condition *RefExistsCondition) Validate() *ConditionResult {
 		scanner := bufio.NewScanner(file)
 		lineNumber := 0
 		for scanner.Scan() {
+			if ctx.Err() != nil {
+				ret.Error = canceledError(ctx)
+				ret.IsSuccess = false
+				return ret
+			}
+
 			lineNumber++
 			lineString := scanner.Text()
 
@@ -50,7 +57,11 @@ func (condition *RefExistsCondition) Validate() *ConditionResult {
 			}
 
 			if len(subMatch) <= 1 {
-				ret.Error = errors.New("no matching group found. Regex may be incorrect")
+				ret.Error = conditionError(NoCaptureGroupCode, ErrorContext{
+					Path:    blueprintrepo.GetRelPath(condition.Path),
+					Line:    lineNumber,
+					Pattern: pattern,
+				}, errors.New("no matching group found. Regex may be incorrect"))
 				ret.IsSuccess = false
 				return ret
 			}
@@ -0,0 +1,323 @@
+package linter
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/logger"
+	"github.com/PrinceMerluza/devcenter-content-linter/utils"
+)
+
+const highEntropyDetector = "high-entropy"
+
+type secretDetector struct {
+	Name        string
+	Description string
+	Re          *regexp.Regexp // the first matching group is the secret value if defined
+	NoRedact    bool           // the match is only a marker of the secret, not the value itself
+}
+
+// Built-in detectors for known credential formats
+var secretDetectors = []secretDetector{
+	{
+		Name:        "genesys-client-secret",
+		Description: "Genesys Cloud OAuth client secret",
+		Re:          regexp.MustCompile(`(?i)(?:client_?secret|oauthclient_secret|client-secret)["']?\s*[:=]\s*["']?([A-Za-z0-9_\-]{43})\b`),
+	},
+	{
+		Name:        "aws-access-key",
+		Description: "AWS access key ID",
+		Re:          regexp.MustCompile(`\b((?:AKIA|ASIA|AGPA|AIDA|AROA|ANPA)[0-9A-Z]{16})\b`),
+	},
+	{
+		Name:        "aws-secret-key",
+		Description: "AWS secret access key",
+		Re:          regexp.MustCompile(`(?i)aws.{0,20}?(?:secret|key)["']?\s*[:=]\s*["']?([A-Za-z0-9/+=]{40})\b`),
+	},
+	{
+		Name:        "github-token",
+		Description: "GitHub token",
+		Re:          regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{36,255}|github_pat_[A-Za-z0-9_]{22,255})\b`),
+	},
+	{
+		Name:        "private-key",
+		Description: "private key",
+		Re:          regexp.MustCompile(`-----BEGIN (?:[A-Z]+ )?PRIVATE KEY(?: BLOCK)?-----`),
+		NoRedact:    true,
+	},
+}
+
+// Candidates for the high entropy check
+var entropyTokenRe = regexp.MustCompile(`[A-Za-z0-9+/=_\-]{20,}`)
+
+type SecretsCondition struct {
+	Path     string
+	Secrets  *config.SecretsCondition
+	compiled *compiledPatterns // set by the plan
+}
+
+// A secret found in a line
+type secretMatch struct {
+	Detector    string
+	Description string
+	Start       int // byte offset of the value in the line
+	End         int
+	NoRedact    bool
+}
+
+func (condition *SecretsCondition) Validate(ctx context.Context) *ConditionResult {
+	ret := &ConditionResult{
+		FileHighlights: &[]FileHighlight{},
+		IsSuccess:      true,
+	}
+	secrets := condition.Secrets
+
+	detectors, err := enabledSecretDetectors(secrets.Detectors)
+	if err != nil {
+		ret.Error = err
+		ret.IsSuccess = false
+		return ret
+	}
+
+	allowlist := map[string][]*regexp.Regexp{}
+	for name, patterns := range secrets.Allowlist {
+		for _, pattern := range patterns {
+			re, err := condition.compiled.regex(pattern)
+			if err != nil {
+				ret.Error = err
+				ret.IsSuccess = false
+				return ret
+			}
+			allowlist[strings.ToLower(name)] = append(allowlist[strings.ToLower(name)], re)
+		}
+	}
+	isAllowed := func(detector string, value string) bool {
+		for _, name := range []string{detector, "*"} {
+			for _, re := range allowlist[name] {
+				if re.MatchString(value) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	paths := []string{condition.Path}
+	if len(secrets.Files) > 0 {
+		globs, err := condition.compiled.globList(secrets.Files)
+		if err != nil {
+			ret.Error = err
+			ret.IsSuccess = false
+			return ret
+		}
+		paths, err = utils.Glob(condition.Path, globs)
+		if err != nil {
+			ret.Error = err
+			ret.IsSuccess = false
+			return ret
+		}
+	}
+
+	for _, path := range paths {
+		if ctx.Err() != nil {
+			ret.Error = canceledError(ctx)
+			ret.IsSuccess = false
+			return ret
+		}
+
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			continue
+		}
+
+		logger.Tracef("Opening file %s \n", path)
+		fileData, err := os.ReadFile(path)
+		if err != nil {
+			ret.Error = err
+			ret.IsSuccess = false
+			return ret
+		}
+
+		// Skip binary files like images
+		if bytes.IndexByte(fileData[:minInt(len(fileData), 8000)], 0) >= 0 {
+			continue
+		}
+
+		scanner := bufio.NewScanner(bytes.NewReader(fileData))
+		scanner.Buffer(make([]byte, 0, 64*1024), len(fileData)+1)
+		lineNumber := 0
+		for scanner.Scan() {
+			if ctx.Err() != nil {
+				ret.Error = canceledError(ctx)
+				ret.IsSuccess = false
+				return ret
+			}
+
+			lineNumber++
+			line := scanner.Text()
+
+			matches := findSecrets(line, detectors, secrets.MinEntropy)
+			for _, match := range matches {
+				if isAllowed(match.Detector, line[match.Start:match.End]) {
+					continue
+				}
+
+				ret.IsSuccess = false
+				*ret.FileHighlights = append(*ret.FileHighlights, FileHighlight{
+					Path:        blueprintrepo.GetRelPath(path),
+					LineNumber:  lineNumber,
+					Column:      utf8.RuneCountInString(line[:match.Start]) + 1,
+					EndColumn:   utf8.RuneCountInString(line[:match.End]) + 1,
+					LineCount:   1,
+					LineContent: strings.TrimSpace(redactSecrets(line, matches)),
+					Message:     fmt.Sprintf("possible %s (%s)", match.Description, match.Detector),
+					Sensitive:   true,
+				})
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			ret.Error = err
+			ret.IsSuccess = false
+			return ret
+		}
+	}
+
+	return ret
+}
+
+// Get the built-in detectors by name. All of them if no names are given.
+func enabledSecretDetectors(names []string) ([]secretDetector, error) {
+	if len(names) == 0 {
+		return secretDetectors, nil
+	}
+
+	ret := []secretDetector{}
+	for _, name := range names {
+		found := false
+		for _, detector := range secretDetectors {
+			if detector.Name == strings.ToLower(name) {
+				ret = append(ret, detector)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, conditionError(InvalidValueCode, ErrorContext{}, fmt.Errorf("unknown secret detector %s", name))
+		}
+	}
+
+	return ret, nil
+}
+
+// Find the secrets in a line. Strings with a Shannon entropy of at least
+// minEntropy are reported if not already matched by a detector. The check
+// is disabled if minEntropy is 0.
+func findSecrets(line string, detectors []secretDetector, minEntropy float64) []secretMatch {
+	matches := []secretMatch{}
+	overlaps := func(start int, end int) bool {
+		for _, match := range matches {
+			if start < match.End && end > match.Start {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, detector := range detectors {
+		for _, loc := range detector.Re.FindAllStringSubmatchIndex(line, -1) {
+			start, end := loc[0], loc[1]
+			if len(loc) >= 4 && loc[2] >= 0 {
+				start, end = loc[2], loc[3]
+			}
+			if overlaps(start, end) {
+				continue
+			}
+
+			matches = append(matches, secretMatch{
+				Detector:    detector.Name,
+				Description: detector.Description,
+				Start:       start,
+				End:         end,
+				NoRedact:    detector.NoRedact,
+			})
+		}
+	}
+
+	if minEntropy <= 0 {
+		return matches
+	}
+
+	for _, loc := range entropyTokenRe.FindAllStringIndex(line, -1) {
+		if overlaps(loc[0], loc[1]) || shannonEntropy(line[loc[0]:loc[1]]) < minEntropy {
+			continue
+		}
+
+		matches = append(matches, secretMatch{
+			Detector:    highEntropyDetector,
+			Description: "high entropy string",
+			Start:       loc[0],
+			End:         loc[1],
+		})
+	}
+
+	return matches
+}
+
+// Replace the secret values in the line. Only the first few characters are
+// kept so the value can still be identified.
+func redactSecrets(line string, matches []secretMatch) string {
+	redacted := []byte(line)
+	for _, match := range matches {
+		if match.NoRedact {
+			continue
+		}
+
+		keep := 0
+		if match.End-match.Start > 12 {
+			keep = 4
+		}
+		for i := match.Start + keep; i < match.End; i++ {
+			redacted[i] = '*'
+		}
+	}
+
+	return string(redacted)
+}
+
+// Shannon entropy in bits per character
+func shannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+
+	counts := map[rune]int{}
+	total := 0
+	for _, r := range s {
+		counts[r]++
+		total++
+	}
+
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
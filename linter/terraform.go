@@ -0,0 +1,270 @@
+package linter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/logger"
+	"github.com/PrinceMerluza/devcenter-content-linter/utils"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var defaultTerraformFiles = []string{"**/*.tf"}
+
+type TerraformCondition struct {
+	Path      string
+	Terraform *config.TerraformCondition
+	compiled  *compiledPatterns // set by the plan
+}
+
+// A provider declared in a required_providers block
+type requiredProvider struct {
+	Source     string
+	HasVersion bool
+	Path       string
+	Range      hcl.Range
+}
+
+func (condition *TerraformCondition) Validate(ctx context.Context) *ConditionResult {
+	ret := &ConditionResult{
+		FileHighlights: &[]FileHighlight{},
+		IsSuccess:      true,
+	}
+	terraform := condition.Terraform
+
+	if _, err := os.Stat(condition.Path); err != nil {
+		ret.Error = err
+		ret.IsSuccess = false
+		return ret
+	}
+
+	patterns := terraform.Files
+	if len(patterns) == 0 {
+		patterns = defaultTerraformFiles
+	}
+	globs, err := condition.compiled.globList(patterns)
+	if err != nil {
+		ret.Error = err
+		ret.IsSuccess = false
+		return ret
+	}
+	paths, err := utils.Glob(condition.Path, globs)
+	if err != nil {
+		ret.Error = err
+		ret.IsSuccess = false
+		return ret
+	}
+
+	sources := map[string][]string{}
+	addHighlight := func(path string, rng hcl.Range, message string) {
+		lineContent, _ := utils.GetStringAtLine(strings.Join(sources[path], "\n"), rng.Start.Line)
+		lineCount := rng.End.Line - rng.Start.Line + 1
+		if lineCount < 1 {
+			lineCount = 1
+		}
+
+		ret.IsSuccess = false
+		*ret.FileHighlights = append(*ret.FileHighlights, FileHighlight{
+			Path:        blueprintrepo.GetRelPath(path),
+			LineNumber:  rng.Start.Line,
+			Column:      rng.Start.Column,
+			LineCount:   lineCount,
+			LineContent: strings.TrimSpace(lineContent),
+			Message:     message,
+		})
+	}
+
+	providers := []requiredProvider{}
+	usages := map[string]hcl.Range{} // first usage of a provider's local name
+	usagePaths := map[string]string{}
+	for _, path := range paths {
+		if ctx.Err() != nil {
+			ret.Error = canceledError(ctx)
+			ret.IsSuccess = false
+			return ret
+		}
+
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			continue
+		}
+
+		logger.Tracef("Opening file %s \n", path)
+		fileData, err := os.ReadFile(path)
+		if err != nil {
+			ret.Error = err
+			ret.IsSuccess = false
+			return ret
+		}
+		sources[path] = strings.Split(string(fileData), "\n")
+
+		file, diags := hclsyntax.ParseConfig(fileData, path, hcl.Pos{Line: 1, Column: 1})
+		if diags.HasErrors() {
+			for _, diag := range diags {
+				if diag.Severity != hcl.DiagError || diag.Subject == nil {
+					continue
+				}
+				addHighlight(path, *diag.Subject, fmt.Sprintf("%s: %s", diag.Summary, diag.Detail))
+			}
+			continue
+		}
+
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		providers = append(providers, findRequiredProviders(body, path)...)
+
+		for _, block := range body.Blocks {
+			localName := ""
+			switch {
+			case block.Type == "provider" && len(block.Labels) > 0:
+				localName = block.Labels[0]
+			case (block.Type == "resource" || block.Type == "data") && len(block.Labels) > 0:
+				localName = strings.SplitN(block.Labels[0], "_", 2)[0]
+			}
+			if _, ok := usages[localName]; localName != "" && !ok {
+				usages[localName] = block.TypeRange
+				usagePaths[localName] = path
+			}
+		}
+
+		for _, name := range terraform.NoHardcodedAttributes {
+			for _, attr := range findAttributes(body, name) {
+				if isHardcoded(attr.Expr) {
+					addHighlight(path, attr.SrcRange, fmt.Sprintf("%s must not be hard-coded, use a variable instead", attr.Name))
+				}
+			}
+		}
+	}
+
+	for _, source := range terraform.PinnedProviders {
+		declared := false
+		for _, provider := range providers {
+			if !strings.EqualFold(normalizeProviderSource(provider.Source), normalizeProviderSource(source)) {
+				continue
+			}
+
+			declared = true
+			if !provider.HasVersion {
+				addHighlight(provider.Path, provider.Range, fmt.Sprintf("provider %s must be pinned with a version constraint", source))
+			}
+		}
+
+		// Used without being declared in required_providers
+		localName := source[strings.LastIndex(source, "/")+1:]
+		if rng, used := usages[localName]; !declared && used {
+			addHighlight(usagePaths[localName], rng, fmt.Sprintf("provider %s must be declared in required_providers with a version constraint", source))
+		}
+	}
+
+	return ret
+}
+
+// Get the providers declared in terraform { required_providers { ... } }
+func findRequiredProviders(body *hclsyntax.Body, path string) []requiredProvider {
+	providers := []requiredProvider{}
+
+	for _, block := range body.Blocks {
+		if block.Type != "terraform" {
+			continue
+		}
+
+		for _, inner := range block.Body.Blocks {
+			if inner.Type != "required_providers" {
+				continue
+			}
+
+			attrs := []*hclsyntax.Attribute{}
+			for _, attr := range inner.Body.Attributes {
+				attrs = append(attrs, attr)
+			}
+			sort.Slice(attrs, func(i, j int) bool {
+				return attrs[i].SrcRange.Start.Byte < attrs[j].SrcRange.Start.Byte
+			})
+
+			for _, attr := range attrs {
+				obj, ok := attr.Expr.(*hclsyntax.ObjectConsExpr)
+				if !ok {
+					continue
+				}
+
+				provider := requiredProvider{
+					Path:  path,
+					Range: attr.SrcRange,
+				}
+				for _, item := range obj.Items {
+					key := hcl.ExprAsKeyword(item.KeyExpr)
+					if key == "" {
+						if val, diags := item.KeyExpr.Value(nil); !diags.HasErrors() && val.Type() == cty.String {
+							key = val.AsString()
+						}
+					}
+
+					val, diags := item.ValueExpr.Value(nil)
+					if diags.HasErrors() || val.IsNull() || val.Type() != cty.String {
+						continue
+					}
+
+					switch key {
+					case "source":
+						provider.Source = val.AsString()
+					case "version":
+						provider.HasVersion = strings.TrimSpace(val.AsString()) != ""
+					}
+				}
+
+				if provider.Source == "" {
+					provider.Source = "hashicorp/" + attr.Name
+				}
+				providers = append(providers, provider)
+			}
+		}
+	}
+
+	return providers
+}
+
+// Find all the attributes with the name in the body and nested blocks
+func findAttributes(body *hclsyntax.Body, name string) []*hclsyntax.Attribute {
+	attrs := []*hclsyntax.Attribute{}
+
+	if attr, ok := body.Attributes[name]; ok {
+		attrs = append(attrs, attr)
+	}
+	for _, block := range body.Blocks {
+		attrs = append(attrs, findAttributes(block.Body, name)...)
+	}
+
+	return attrs
+}
+
+// Check if the expression is a literal value instead of a reference to a
+// variable or the result of a function
+func isHardcoded(expr hclsyntax.Expression) bool {
+	switch e := expr.(type) {
+	case *hclsyntax.LiteralValueExpr:
+		return !e.Val.IsNull()
+	case *hclsyntax.TemplateExpr:
+		return e.IsStringLiteral()
+	}
+
+	return false
+}
+
+// Remove the registry hostname from the provider source
+func normalizeProviderSource(source string) string {
+	parts := strings.Split(source, "/")
+	if len(parts) == 3 {
+		return strings.Join(parts[1:], "/")
+	}
+
+	return source
+}
@@ -0,0 +1,136 @@
+package linter
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTerraformCondition_Validate(t *testing.T) {
+	policies := &config.TerraformCondition{
+		PinnedProviders:       []string{"mypurecloud/genesyscloud"},
+		NoHardcodedAttributes: []string{"oauthclient_secret"},
+	}
+
+	tests := []struct {
+		name      string
+		condition *TerraformCondition
+		want      *ConditionResult
+	}{
+		{
+			name: "Valid Terraform",
+			condition: &TerraformCondition{
+				Path:      terraformGood,
+				Terraform: policies,
+			},
+			want: &ConditionResult{
+				IsSuccess:      true,
+				FileHighlights: &[]FileHighlight{},
+			},
+		},
+		{
+			name: "Unpinned Provider and Hard-coded Secret",
+			condition: &TerraformCondition{
+				Path:      terraformBad,
+				Terraform: policies,
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:        relPath(filepath.Join(terraformBad, "main.tf")),
+						LineNumber:  11,
+						Column:      3,
+						LineCount:   1,
+						LineContent: "oauthclient_secret = \"not-a-real-secret\"",
+						Message:     "oauthclient_secret must not be hard-coded, use a variable instead",
+					},
+					{
+						Path:        relPath(filepath.Join(terraformBad, "main.tf")),
+						LineNumber:  3,
+						Column:      5,
+						LineCount:   3,
+						LineContent: "genesyscloud = {",
+						Message:     "provider mypurecloud/genesyscloud must be pinned with a version constraint",
+					},
+				},
+			},
+		},
+		{
+			name: "Undeclared Provider",
+			condition: &TerraformCondition{
+				Path:      terraformUndeclared,
+				Terraform: policies,
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:        relPath(filepath.Join(terraformUndeclared, "main.tf")),
+						LineNumber:  1,
+						Column:      1,
+						LineCount:   1,
+						LineContent: "resource \"genesyscloud_routing_queue\" \"queue\" {",
+						Message:     "provider mypurecloud/genesyscloud must be declared in required_providers with a version constraint",
+					},
+				},
+			},
+		},
+		{
+			name: "Syntax Error",
+			condition: &TerraformCondition{
+				Path:      terraformBroken,
+				Terraform: &config.TerraformCondition{},
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:        relPath(filepath.Join(terraformBroken, "main.tf")),
+						LineNumber:  3,
+						Column:      17,
+						LineCount:   2,
+						LineContent: "description =",
+						Message:     "Invalid expression: Expected the start of an expression, but found an invalid expression token.",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Validate(context.Background()); !cmp.Equal(got, tt.want) {
+				t.Errorf("%v", cmp.Diff(got, tt.want))
+				if got.Error != nil {
+					t.Errorf("Error: %v", got.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestTerraformCondition_ValidateWithErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *TerraformCondition
+	}{
+		{
+			name: "Non-existent Path",
+			condition: &TerraformCondition{
+				Path:      incorrectPath,
+				Terraform: &config.TerraformCondition{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Validate(context.Background()); got.Error == nil {
+				t.Errorf("Expected error, got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+terraform {
+  required_providers {
+    genesyscloud = {
+      source = "registry.terraform.io/mypurecloud/genesyscloud"
+    }
+  }
+}
+
+provider "genesyscloud" {
+  oauthclient_id     = "7de3af06-c0b3-4f9b-af45-72f4a1403797"
+  oauthclient_secret = "not-a-real-secret"
+  aws_region         = "us-east-1"
+}
@@ -0,0 +1,4 @@
+resource "genesyscloud_routing_queue" "queue" {
+  name = "Sample Queue"
+  description = 
+}
@@ -0,0 +1,14 @@
+terraform {
+  required_providers {
+    genesyscloud = {
+      source  = "mypurecloud/genesyscloud"
+      version = "~> 1.6"
+    }
+  }
+}
+
+provider "genesyscloud" {
+  oauthclient_id     = var.client_id
+  oauthclient_secret = var.client_secret
+  aws_region         = "us-east-1"
+}
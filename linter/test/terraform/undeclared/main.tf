@@ -0,0 +1,3 @@
+resource "genesyscloud_routing_queue" "queue" {
+  name = "Sample Queue"
+}
@@ -10,9 +10,36 @@ var (
 	emptyFile       string = "./test/empty.md"
 	containsFile    string = "./test/contains.md"
 	notContainsFile string = "./test/notcontains.md"
-	refExists       string = "./test/refExists.md"
-	refExists2      string = "./test/refExists2.md"
+	refExists       string = "./test/refexists.md"
+	refExists2      string = "./test/refexists2.md"
 	incorrectPath   string = "./aasifGJASDIOOJ123LKRJAWSLIEUWE/qadGHQAWIUEHAWE"
+
+	integrationSchema     string = "./test/jsonschema/integration.schema.json"
+	validIntegration      string = "./test/jsonschema/valid.json"
+	invalidIntegration    string = "./test/jsonschema/invalid.json"
+	invalidIntegrationYml string = "./test/jsonschema/invalid.yaml"
+	malformedIntegration  string = "./test/jsonschema/malformed.json"
+	emptyIntegrationYml   string = "./test/jsonschema/empty.yaml"
+
+	codeBlocksFile string = "./test/codeblocks.md"
+
+	textHygieneDir   string = "./test/texthygiene"
+	textHygieneDirty string = "./test/texthygiene/dirty.md"
+	textHygieneClean string = "./test/texthygiene/clean.md"
+
+	pathsDir          string = "./test/paths"
+	pathsBlueprintDir string = "./test/paths/blueprint"
+
+	namingDir string = "./test/naming"
+
+	secretsDir    string = "./test/secrets"
+	secretsConfig string = "./test/secrets/config.js"
+	secretsKey    string = "./test/secrets/key.md"
+
+	terraformGood       string = "./test/terraform/good"
+	terraformBad        string = "./test/terraform/bad"
+	terraformUndeclared string = "./test/terraform/undeclared"
+	terraformBroken     string = "./test/terraform/broken"
 )
 
 func relPath(path string) string {
@@ -0,0 +1,229 @@
+package linter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/logger"
+	"github.com/PrinceMerluza/devcenter-content-linter/utils"
+)
+
+var utf8Bom = []byte{0xEF, 0xBB, 0xBF}
+
+// Characters that are invisible or look like a regular space
+var suspiciousChars = map[rune]string{
+	'\u00A0': "non-breaking space",
+	'\u200B': "zero-width space",
+	'\u200C': "zero-width non-joiner",
+	'\u200D': "zero-width joiner",
+	'\u2060': "word joiner",
+	'\uFEFF': "zero-width no-break space",
+	'\u202F': "narrow no-break space",
+	'\u00AD': "soft hyphen",
+}
+
+// Characters that break code when copied
+var smartQuotes = map[rune]string{
+	'\u2018': "left single quotation mark",
+	'\u2019': "right single quotation mark",
+	'\u201C': "left double quotation mark",
+	'\u201D': "right double quotation mark",
+}
+
+type TextHygieneCondition struct {
+	Path        string
+	TextHygiene *config.TextHygieneCondition
+	compiled    *compiledPatterns // set by the plan
+}
+
+func (condition *TextHygieneCondition) Validate(ctx context.Context) *ConditionResult {
+	ret := &ConditionResult{
+		FileHighlights: &[]FileHighlight{},
+		IsSuccess:      true,
+	}
+
+	paths := []string{condition.Path}
+	if len(condition.TextHygiene.Files) > 0 {
+		globs, err := condition.compiled.globList(condition.TextHygiene.Files)
+		if err != nil {
+			ret.Error = err
+			ret.IsSuccess = false
+			return ret
+		}
+		matches, err := utils.Glob(condition.Path, globs)
+		if err != nil {
+			ret.Error = err
+			ret.IsSuccess = false
+			return ret
+		}
+		paths = matches
+	}
+
+	for _, path := range paths {
+		if ctx.Err() != nil {
+			ret.Error = canceledError(ctx)
+			ret.IsSuccess = false
+			return ret
+		}
+
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			continue
+		}
+
+		logger.Tracef("Opening file %s \n", path)
+		fileData, err := os.ReadFile(path)
+		if err != nil {
+			ret.Error = err
+			ret.IsSuccess = false
+			return ret
+		}
+
+		for _, highlight := range checkTextHygiene(condition.TextHygiene, fileData, path) {
+			ret.IsSuccess = false
+			*ret.FileHighlights = append(*ret.FileHighlights, highlight)
+		}
+	}
+
+	return ret
+}
+
+// Check the file data and return a highlight for every violation
+func checkTextHygiene(checks *config.TextHygieneCondition, fileData []byte, path string) []FileHighlight {
+	highlights := []FileHighlight{}
+	relPath := blueprintrepo.GetRelPath(path)
+	addHighlight := func(lineNumber int, column int, line string, message string) {
+		highlights = append(highlights, FileHighlight{
+			Path:        relPath,
+			LineNumber:  lineNumber,
+			Column:      column,
+			LineCount:   1,
+			LineContent: strings.TrimSpace(strings.ToValidUTF8(line, "\uFFFD")),
+			Message:     message,
+		})
+	}
+
+	if checks.NoBom && bytes.HasPrefix(fileData, utf8Bom) {
+		firstLine := strings.SplitN(string(fileData[len(utf8Bom):]), "\n", 2)[0]
+		addHighlight(1, 1, firstLine, "file starts with a UTF-8 byte order mark")
+	}
+	fileData = bytes.TrimPrefix(fileData, utf8Bom)
+
+	ext := strings.ToLower(filepath.Ext(path))
+	isMarkdown := ext == ".md" || ext == ".markdown"
+	lineEndings := strings.ToLower(checks.LineEndings)
+
+	lines := strings.Split(string(fileData), "\n")
+	inFrontMatter := false
+	inFence := ""
+	for i, line := range lines {
+		lineNumber := i + 1
+
+		// The last element is empty when the file ends with a newline
+		if i == len(lines)-1 && line == "" {
+			break
+		}
+
+		hasCR := strings.HasSuffix(line, "\r")
+		line = strings.TrimSuffix(line, "\r")
+		isLastLine := i == len(lines)-1
+
+		if checks.Utf8 && !utf8.ValidString(line) {
+			column := 1
+			for j := 0; j < len(line); {
+				r, size := utf8.DecodeRuneInString(line[j:])
+				if r == utf8.RuneError && size <= 1 {
+					break
+				}
+				j += size
+				column++
+			}
+			addHighlight(lineNumber, column, line, "invalid UTF-8 byte sequence")
+		}
+
+		if !isLastLine {
+			switch {
+			case lineEndings == "lf" && hasCR:
+				addHighlight(lineNumber, utf8.RuneCountInString(line)+1, line, "line ends with CRLF, expected LF")
+			case lineEndings == "crlf" && !hasCR:
+				addHighlight(lineNumber, utf8.RuneCountInString(line)+1, line, "line ends with LF, expected CRLF")
+			}
+		}
+
+		if checks.NoTrailingWhitespace {
+			trimmed := strings.TrimRight(line, " \t")
+			if len(trimmed) != len(line) {
+				addHighlight(lineNumber, utf8.RuneCountInString(trimmed)+1, line, "trailing whitespace")
+			}
+		}
+
+		if checks.MaxLineLength > 0 {
+			if length := utf8.RuneCountInString(line); length > checks.MaxLineLength {
+				addHighlight(lineNumber, checks.MaxLineLength+1, line,
+					fmt.Sprintf("line is %d characters long, maximum is %d", length, checks.MaxLineLength))
+			}
+		}
+
+		// Front matter is only at the very start of the file
+		if isMarkdown && strings.TrimSpace(line) == "---" {
+			if lineNumber == 1 {
+				inFrontMatter = true
+				continue
+			}
+			if inFrontMatter {
+				inFrontMatter = false
+				continue
+			}
+		}
+		if inFrontMatter && checks.NoFrontMatterTabs {
+			if index := strings.IndexByte(line, '\t'); index >= 0 {
+				addHighlight(lineNumber, utf8.RuneCountInString(line[:index])+1, line, "tab character in front matter")
+			}
+		}
+
+		if !checks.NoSuspiciousUnicode {
+			continue
+		}
+
+		// Fenced code blocks in Markdown. Everything is code in other files.
+		isFenceLine := false
+		if isMarkdown {
+			if match := fenceRe.FindStringSubmatch(line); match != nil {
+				isFenceLine = true
+				if inFence == "" {
+					inFence = match[2][:1]
+				} else if strings.HasPrefix(match[2], inFence) && strings.TrimSpace(match[3]) == "" {
+					inFence = ""
+				}
+			}
+		}
+
+		inInlineCode := false
+		column := 0
+		for _, r := range line {
+			column++
+			if r == '`' && isMarkdown && inFence == "" && !isFenceLine {
+				inInlineCode = !inInlineCode
+				continue
+			}
+
+			if name, ok := suspiciousChars[r]; ok {
+				addHighlight(lineNumber, column, line, fmt.Sprintf("suspicious character U+%04X (%s)", r, name))
+				continue
+			}
+
+			isCode := !isMarkdown || inFence != "" || inInlineCode
+			if name, ok := smartQuotes[r]; ok && isCode {
+				addHighlight(lineNumber, column, line, fmt.Sprintf("smart quote U+%04X (%s) in code", r, name))
+			}
+		}
+	}
+
+	return highlights
+}
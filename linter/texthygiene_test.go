@@ -0,0 +1,238 @@
+package linter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTextHygieneCondition_Validate(t *testing.T) {
+	allChecks := &config.TextHygieneCondition{
+		Utf8:                 true,
+		NoBom:                true,
+		LineEndings:          "lf",
+		NoTrailingWhitespace: true,
+		MaxLineLength:        50,
+		NoFrontMatterTabs:    true,
+		NoSuspiciousUnicode:  true,
+	}
+
+	tests := []struct {
+		name      string
+		condition *TextHygieneCondition
+		want      *ConditionResult
+	}{
+		{
+			name: "Empty Markdown",
+			condition: &TextHygieneCondition{
+				Path:        emptyFile,
+				TextHygiene: allChecks,
+			},
+			want: &ConditionResult{
+				IsSuccess:      true,
+				FileHighlights: &[]FileHighlight{},
+			},
+		},
+		{
+			name: "Clean Markdown",
+			condition: &TextHygieneCondition{
+				Path:        textHygieneClean,
+				TextHygiene: allChecks,
+			},
+			want: &ConditionResult{
+				IsSuccess:      true,
+				FileHighlights: &[]FileHighlight{},
+			},
+		},
+		{
+			name: "Dirty Markdown",
+			condition: &TextHygieneCondition{
+				Path:        textHygieneDirty,
+				TextHygiene: allChecks,
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:        relPath(textHygieneDirty),
+						LineNumber:  1,
+						Column:      1,
+						LineCount:   1,
+						LineContent: "---",
+						Message:     "file starts with a UTF-8 byte order mark",
+					},
+					{
+						Path:        relPath(textHygieneDirty),
+						LineNumber:  3,
+						Column:      8,
+						LineCount:   1,
+						LineContent: "author:\tsomeone",
+						Message:     "tab character in front matter",
+					},
+					{
+						Path:        relPath(textHygieneDirty),
+						LineNumber:  5,
+						Column:      13,
+						LineCount:   1,
+						LineContent: "Windows line",
+						Message:     "line ends with CRLF, expected LF",
+					},
+					{
+						Path:        relPath(textHygieneDirty),
+						LineNumber:  6,
+						Column:      15,
+						LineCount:   1,
+						LineContent: "Trailing space",
+						Message:     "trailing whitespace",
+					},
+					{
+						Path:        relPath(textHygieneDirty),
+						LineNumber:  7,
+						Column:      7,
+						LineCount:   1,
+						LineContent: "Pasted\u00a0from Word",
+						Message:     "suspicious character U+00A0 (non-breaking space)",
+					},
+					{
+						Path:        relPath(textHygieneDirty),
+						LineNumber:  8,
+						Column:      40,
+						LineCount:   1,
+						LineContent: "Use “quotes” in text but not in `print(“hi”)`",
+						Message:     "smart quote U+201C (left double quotation mark) in code",
+					},
+					{
+						Path:        relPath(textHygieneDirty),
+						LineNumber:  8,
+						Column:      43,
+						LineCount:   1,
+						LineContent: "Use “quotes” in text but not in `print(“hi”)`",
+						Message:     "smart quote U+201D (right double quotation mark) in code",
+					},
+					{
+						Path:        relPath(textHygieneDirty),
+						LineNumber:  10,
+						Column:      11,
+						LineCount:   1,
+						LineContent: "const a = ‘b’;",
+						Message:     "smart quote U+2018 (left single quotation mark) in code",
+					},
+					{
+						Path:        relPath(textHygieneDirty),
+						LineNumber:  10,
+						Column:      13,
+						LineCount:   1,
+						LineContent: "const a = ‘b’;",
+						Message:     "smart quote U+2019 (right single quotation mark) in code",
+					},
+					{
+						Path:        relPath(textHygieneDirty),
+						LineNumber:  12,
+						Column:      8,
+						LineCount:   1,
+						LineContent: "Broken \uFFFD byte",
+						Message:     "invalid UTF-8 byte sequence",
+					},
+					{
+						Path:        relPath(textHygieneDirty),
+						LineNumber:  13,
+						Column:      51,
+						LineCount:   1,
+						LineContent: "This line is definitely longer than forty characters in total.",
+						Message:     "line is 62 characters long, maximum is 50",
+					},
+				},
+			},
+		},
+		{
+			name: "Files Glob",
+			condition: &TextHygieneCondition{
+				Path: textHygieneDir,
+				TextHygiene: &config.TextHygieneCondition{
+					Files:       []string{"**/clean.md"},
+					LineEndings: "crlf",
+				},
+			},
+			want: &ConditionResult{
+				IsSuccess: false,
+				FileHighlights: &[]FileHighlight{
+					{
+						Path:        relPath(textHygieneClean),
+						LineNumber:  1,
+						Column:      4,
+						LineCount:   1,
+						LineContent: "---",
+						Message:     "line ends with LF, expected CRLF",
+					},
+					{
+						Path:        relPath(textHygieneClean),
+						LineNumber:  2,
+						Column:      13,
+						LineCount:   1,
+						LineContent: "title: Clean",
+						Message:     "line ends with LF, expected CRLF",
+					},
+					{
+						Path:        relPath(textHygieneClean),
+						LineNumber:  3,
+						Column:      4,
+						LineCount:   1,
+						LineContent: "---",
+						Message:     "line ends with LF, expected CRLF",
+					},
+					{
+						Path:        relPath(textHygieneClean),
+						LineNumber:  4,
+						Column:      1,
+						LineCount:   1,
+						LineContent: "",
+						Message:     "line ends with LF, expected CRLF",
+					},
+					{
+						Path:        relPath(textHygieneClean),
+						LineNumber:  5,
+						Column:      21,
+						LineCount:   1,
+						LineContent: "Nothing to see here.",
+						Message:     "line ends with LF, expected CRLF",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Validate(context.Background()); !cmp.Equal(got, tt.want) {
+				t.Errorf("%v", cmp.Diff(got, tt.want))
+				if got.Error != nil {
+					t.Errorf("Error: %v", got.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestTextHygieneCondition_ValidateWithErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *TextHygieneCondition
+	}{
+		{
+			name: "Non-existent Path",
+			condition: &TextHygieneCondition{
+				Path:        incorrectPath,
+				TextHygiene: &config.TextHygieneCondition{Utf8: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Validate(context.Background()); got.Error == nil {
+				t.Errorf("Expected error, got nil")
+			}
+		})
+	}
+}
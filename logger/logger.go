@@ -61,16 +61,24 @@ func InitLogger() {
 	log.SetOutput(file)
 }
 
+// Trace messages only go to stderr with logging enabled, so they don't get
+// mixed into the report
 func Trace(v ...interface{}) {
+	if !LoggingEnabled {
+		return
+	}
 	fmt.Fprint(os.Stderr, v...)
-	if traceLogger != nil && LoggingEnabled {
+	if traceLogger != nil {
 		traceLogger.Println(v...)
 	}
 }
 
 func Tracef(format string, v ...interface{}) {
+	if !LoggingEnabled {
+		return
+	}
 	fmt.Fprintf(os.Stderr, format, v...)
-	if traceLogger != nil && LoggingEnabled {
+	if traceLogger != nil {
 		traceLogger.Printf(format, v...)
 	}
 }
@@ -0,0 +1,51 @@
+package presets
+
+import (
+	"embed"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const fileSuffix = ".rule.yaml"
+
+// Built-in rule sets, one for each type of content
+//
+//go:embed *.rule.yaml
+var files embed.FS
+
+// Get the names of the built-in rule sets
+func Names() []string {
+	ret := []string{}
+
+	entries, err := files.ReadDir(".")
+	if err != nil {
+		return ret
+	}
+	for _, entry := range entries {
+		ret = append(ret, strings.TrimSuffix(entry.Name(), fileSuffix))
+	}
+	sort.Strings(ret)
+
+	return ret
+}
+
+// Check if there's a built-in rule set with the name
+func Exists(name string) bool {
+	for _, preset := range Names() {
+		if preset == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Get the rule set file of the preset
+func Get(name string) ([]byte, error) {
+	if !Exists(name) {
+		return nil, fmt.Errorf("unknown preset %s, must be one of: %s", name, strings.Join(Names(), ", "))
+	}
+
+	return files.ReadFile(name + fileSuffix)
+}
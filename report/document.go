@@ -0,0 +1,124 @@
+package report
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/linter"
+)
+
+// Version of the result document. The minor version changes when fields are
+// added, the major version when fields are changed or removed.
+const SchemaVersion = "1.0.0"
+
+// The result of a run as the json format writes it, described by the result
+// JSON Schema
+type Document struct {
+	SchemaVersion string              `json:"schemaVersion"`
+	Linter        DocumentLinter      `json:"linter"`
+	RuleSet       DocumentRuleSet     `json:"ruleSet"`
+	Content       DocumentContent     `json:"content"`
+	StartTime     *time.Time          `json:"startTime,omitempty"`
+	DurationMs    float64             `json:"durationMs"`
+	Summary       DocumentSummary     `json:"summary"`
+	Success       []linter.RuleResult `json:"success"`
+	Failed        []linter.RuleResult `json:"failed"`       // rules the content fails
+	NotEvaluated  []DocumentError     `json:"notEvaluated"` // rules which couldn't be evaluated
+}
+
+type DocumentLinter struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// The rule set, and how it was selected if known
+type DocumentRuleSet struct {
+	Name        string                   `json:"name"`
+	Description string                   `json:"description,omitempty"`
+	Selection   *config.RuleSetSelection `json:"selection,omitempty"`
+}
+
+// The validated content. The path is the URL of remote repositories.
+type DocumentContent struct {
+	Path   string `json:"path"`
+	Remote bool   `json:"remote"`
+}
+
+// Number of rules by outcome. Failed rules are counted by level too.
+type DocumentSummary struct {
+	Rules        int `json:"rules"`
+	Passed       int `json:"passed"`
+	Failed       int `json:"failed"`
+	Errors       int `json:"errors"`
+	Warnings     int `json:"warnings"`
+	NotEvaluated int `json:"notEvaluated"`
+}
+
+// A rule which couldn't be evaluated
+type DocumentError struct {
+	RuleId      string                  `json:"ruleId"`
+	Level       config.Level            `json:"level"`
+	Description string                  `json:"description"`
+	Error       *linter.ValidationError `json:"error"`
+}
+
+// Build the result document of the report
+func NewDocument(report *Report) *Document {
+	counts := report.counts()
+	doc := &Document{
+		SchemaVersion: SchemaVersion,
+		Linter: DocumentLinter{
+			Name:    toolName,
+			Version: report.Version,
+		},
+		DurationMs: float64(report.Result.Duration.Microseconds()) / 1000,
+		Summary: DocumentSummary{
+			Passed:       counts.passed,
+			Failed:       counts.errors + counts.warnings,
+			Errors:       counts.errors,
+			Warnings:     counts.warnings,
+			NotEvaluated: counts.notEvaluated,
+		},
+		Success:      report.successes(),
+		Failed:       []linter.RuleResult{},
+		NotEvaluated: []DocumentError{},
+	}
+	for _, result := range report.failures() {
+		if result.Error == nil {
+			doc.Failed = append(doc.Failed, result)
+			continue
+		}
+		doc.NotEvaluated = append(doc.NotEvaluated, DocumentError{
+			RuleId:      result.Id,
+			Level:       result.Level,
+			Description: result.Description,
+			Error:       result.Error,
+		})
+	}
+	doc.Summary.Rules = doc.Summary.Passed + doc.Summary.Failed + doc.Summary.NotEvaluated
+
+	if report.RuleSet != nil {
+		doc.RuleSet.Name = report.RuleSet.Name
+		doc.RuleSet.Description = report.RuleSet.Description
+	}
+	if report.Result.Metadata != nil {
+		doc.RuleSet.Selection = report.Result.Metadata.RuleSet
+	}
+
+	if report.ContentPath != "" {
+		doc.Content.Path = report.ContentPath
+		if original := blueprintrepo.GetOriginalRelPath(filepath.Clean(report.ContentPath)); original != filepath.Clean(report.ContentPath) {
+			doc.Content.Path = original
+			doc.Content.Remote = true
+		}
+	}
+
+	if !report.Result.StartTime.IsZero() {
+		startTime := report.Result.StartTime.UTC()
+		doc.StartTime = &startTime
+	}
+
+	return doc
+}
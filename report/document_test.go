@@ -0,0 +1,77 @@
+package report
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/linter"
+	"github.com/PrinceMerluza/devcenter-content-linter/schemas"
+	"github.com/PrinceMerluza/devcenter-content-linter/utils"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewDocument(t *testing.T) {
+	report := testReport()
+	report.Version = "v1.2.3"
+	report.ContentPath = "content"
+	report.Result.StartTime = time.Date(2022, 3, 4, 5, 6, 7, 0, time.UTC)
+	report.Result.Duration = 1500 * time.Microsecond
+	report.Result.Metadata = &linter.ResultMetadata{
+		RuleSet: &config.RuleSetSelection{Method: config.SelectedByFlag, Preset: "blueprint"},
+	}
+
+	doc := NewDocument(report)
+
+	if doc.SchemaVersion != SchemaVersion || doc.Linter.Version != "v1.2.3" || doc.DurationMs != 1.5 {
+		t.Errorf("got schema version %s, version %s, duration %v", doc.SchemaVersion, doc.Linter.Version, doc.DurationMs)
+	}
+	if doc.Content != (DocumentContent{Path: "content"}) {
+		t.Errorf("got content %+v", doc.Content)
+	}
+	wantSummary := DocumentSummary{Rules: 4, Passed: 1, Failed: 2, Errors: 1, Warnings: 1, NotEvaluated: 1}
+	if !cmp.Equal(doc.Summary, wantSummary) {
+		t.Errorf("%v", cmp.Diff(doc.Summary, wantSummary))
+	}
+	got := []string{}
+	for _, result := range doc.Failed {
+		got = append(got, result.Id)
+	}
+	if want := []string{"link_1", "struct_0"}; !cmp.Equal(got, want) {
+		t.Errorf("%v", cmp.Diff(got, want))
+	}
+	if len(doc.NotEvaluated) != 1 || doc.NotEvaluated[0].RuleId != "struct_1" || doc.NotEvaluated[0].Error.Code != linter.FileNotFoundCode {
+		t.Errorf("got not evaluated rules %+v", doc.NotEvaluated)
+	}
+}
+
+func TestNewDocument_Schema(t *testing.T) {
+	report := testReport()
+	report.Result.StartTime = time.Now()
+	report.Result.Metadata = &linter.ResultMetadata{
+		RuleSet: &config.RuleSetSelection{Method: config.SelectedByDetection, Preset: "blueprint", IndexType: "blueprint", DetectedIn: "index.md"},
+	}
+
+	data, err := json.Marshal(NewDocument(report))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	violations, err := utils.ValidateJsonSchema(schemas.ResultSchema, data, true)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(violations) > 0 {
+		t.Errorf("document doesn't match the schema: %+v", violations)
+	}
+
+	// The schema is checked at all
+	violations, err = utils.ValidateJsonSchema(schemas.ResultSchema, []byte(`{"schemaVersion": "2.0.0", "success": [{"id": 1}]}`), true)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if len(violations) == 0 {
+		t.Errorf("invalid document matches the schema")
+	}
+}
@@ -0,0 +1,163 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+)
+
+// Escape the value of a workflow command property
+var githubPropertyEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+
+// Escape the message of a workflow command
+var githubMessageEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+
+// Write the report as GitHub Actions workflow commands, which annotate the
+// highlighted lines on the pull request. The job summary is written too if
+// the report has a summary path.
+func writeGithub(w io.Writer, report *Report) error {
+	for _, result := range report.failures() {
+		info, _ := report.rule(result.Id)
+
+		if result.Error != nil {
+			props := map[string]string{"title": result.Id}
+			if file, line := errorLocation(info, result.Error); file != "" {
+				props["file"] = report.workspacePath(file)
+				if line > 0 {
+					props["line"] = fmt.Sprint(line)
+				}
+			}
+			message := fmt.Sprintf("%s could not be evaluated: %s", result.Id, result.Error.Error())
+			if err := writeGithubCommand(w, "error", props, message); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if result.FileHighlights == nil || len(*result.FileHighlights) == 0 {
+			props := map[string]string{"title": result.Id}
+			if file := anchorFile(info); file != "" {
+				props["file"] = report.workspacePath(file)
+			}
+			if err := writeGithubCommand(w, githubCommand(result.Level), props, result.Description); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, highlight := range *result.FileHighlights {
+			props := map[string]string{
+				"title": result.Id,
+				"file":  report.workspacePath(filepath.ToSlash(highlight.Path)),
+			}
+			// Path highlights have no line, so they annotate the whole file
+			if highlight.LineNumber > 0 {
+				props["line"] = fmt.Sprint(highlight.LineNumber)
+				if highlight.LineCount > 1 {
+					props["endLine"] = fmt.Sprint(highlight.LineNumber + highlight.LineCount - 1)
+				}
+				if highlight.Column > 0 {
+					props["col"] = fmt.Sprint(highlight.Column)
+				}
+			}
+			if err := writeGithubCommand(w, githubCommand(result.Level), props, highlightMessage(result, highlight)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if report.SummaryPath == "" {
+		return nil
+	}
+
+	// Other steps may have written to the summary already
+	file, err := os.OpenFile(report.SummaryPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return writeGithubSummary(file, report)
+}
+
+// Get the workflow command of the rule level
+func githubCommand(level config.Level) string {
+	switch level {
+	case config.Error:
+		return "error"
+	case config.Warning:
+		return "warning"
+	}
+
+	return "notice"
+}
+
+func writeGithubCommand(w io.Writer, command string, props map[string]string, message string) error {
+	// In the order GitHub documents them
+	keys := []string{"title", "file", "col", "endColumn", "line", "endLine"}
+	params := []string{}
+	for _, key := range keys {
+		if value, ok := props[key]; ok {
+			params = append(params, key+"="+githubPropertyEscaper.Replace(value))
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "::%s %s::%s\n", command, strings.Join(params, ","), githubMessageEscaper.Replace(message))
+	return err
+}
+
+// Write the Markdown job summary with the counts and a table of the failures
+func writeGithubSummary(w io.Writer, report *Report) error {
+	var sb strings.Builder
+	counts := report.counts()
+
+	title := toolName
+	if report.RuleSet != nil && report.RuleSet.Name != "" {
+		title = fmt.Sprintf("%s: %s", toolName, report.RuleSet.Name)
+	}
+	fmt.Fprintf(&sb, "## %s\n\n", title)
+	fmt.Fprintf(&sb, "| Passed | Errors | Warnings | Not evaluated |\n")
+	fmt.Fprintf(&sb, "| ---: | ---: | ---: | ---: |\n")
+	fmt.Fprintf(&sb, "| %d | %d | %d | %d |\n\n", counts.passed, counts.errors, counts.warnings, counts.notEvaluated)
+
+	failures := report.failures()
+	if len(failures) == 0 {
+		sb.WriteString("All rules passed.\n")
+	} else {
+		sb.WriteString("| Rule | Level | Location | Description |\n")
+		sb.WriteString("| --- | --- | --- | --- |\n")
+		for _, result := range failures {
+			info, _ := report.rule(result.Id)
+			locations := []string{}
+			if result.FileHighlights != nil {
+				for _, highlight := range *result.FileHighlights {
+					location := report.workspacePath(filepath.ToSlash(highlight.Path))
+					if highlight.LineNumber > 0 {
+						location = fmt.Sprintf("%s:%d", location, highlight.LineNumber)
+					}
+					locations = append(locations, "`"+location+"`")
+				}
+			}
+			level := string(result.Level)
+			description := result.Description
+			file := anchorFile(info)
+			if result.Error != nil {
+				level = "not evaluated"
+				description = fmt.Sprintf("%s (%s)", description, result.Error.Error())
+				file, _ = errorLocation(info, result.Error)
+			}
+			if len(locations) == 0 && file != "" {
+				locations = append(locations, fmt.Sprintf("`%s`", report.workspacePath(file)))
+			}
+			fmt.Fprintf(&sb, "| %s | %s | %s | %s |\n", result.Id, level, strings.Join(locations, "<br>"), markdownCell(description))
+		}
+	}
+	sb.WriteString("\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
@@ -0,0 +1,86 @@
+package report
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWriteGithub(t *testing.T) {
+	report := testReport()
+	report.SummaryPath = filepath.Join(t.TempDir(), "summary.md")
+	if err := os.WriteFile(report.SummaryPath, []byte("# Build\n"), 0644); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "github", report); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	got := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	want := []string{
+		"::warning title=link_1,file=blueprint/index.md,line=3::Links should have alternative text",
+		"::warning title=link_1,file=blueprint/index.md,col=4,line=9,endLine=10::Links should have alternative text: no title",
+		"::error title=struct_0::The README.md must exist",
+		"::error title=struct_1,file=schemas/config.schema.json::struct_1 could not be evaluated: file-not-found: open schemas/config.schema.json: no such file or directory",
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("%v", cmp.Diff(got, want))
+	}
+
+	summary, err := os.ReadFile(report.SummaryPath)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	for _, part := range []string{
+		"# Build\n## gc-linter: Test Rules\n",
+		"| 1 | 1 | 1 | 1 |",
+		"| link_1 | warning | `blueprint/index.md:3`<br>`blueprint/index.md:9` | Links should have alternative text |",
+		"| struct_1 | not evaluated | `schemas/config.schema.json` | The schema must be valid (file-not-found: open schemas/config.schema.json: no such file or directory) |",
+	} {
+		if !strings.Contains(string(summary), part) {
+			t.Errorf("summary doesn't contain %q:\n%s", part, summary)
+		}
+	}
+}
+
+func TestWriteGithub_Path(t *testing.T) {
+	report := testPathReport()
+	report.SummaryPath = filepath.Join(t.TempDir(), "summary.md")
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "github", report); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	want := "::error title=struct_0,file=blueprint/.env::Private files must not be committed: path must not exist\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+
+	summary, err := os.ReadFile(report.SummaryPath)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if part := "| struct_0 | error | `blueprint/.env` |"; !strings.Contains(string(summary), part) {
+		t.Errorf("summary doesn't contain %q:\n%s", part, summary)
+	}
+}
+
+func TestWriteGithubCommand(t *testing.T) {
+	var buf bytes.Buffer
+	props := map[string]string{"title": "a,b: c", "file": "100%.md"}
+	if err := writeGithubCommand(&buf, "error", props, "line 1\nline 2: 50%"); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	want := "::error title=a%2Cb%3A c,file=100%25.md::line 1%0Aline 2: 50%25\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
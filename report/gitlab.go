@@ -0,0 +1,112 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+)
+
+type gitlabIssue struct {
+	Description string         `json:"description"`
+	CheckName   string         `json:"check_name"`
+	Fingerprint string         `json:"fingerprint"`
+	Severity    string         `json:"severity"`
+	Location    gitlabLocation `json:"location"`
+}
+
+type gitlabLocation struct {
+	Path  string      `json:"path"`
+	Lines gitlabLines `json:"lines"`
+}
+
+type gitlabLines struct {
+	Begin int `json:"begin"`
+	End   int `json:"end,omitempty"`
+}
+
+// Get the Code Quality severity of the rule level
+func gitlabSeverity(level config.Level) string {
+	switch level {
+	case config.Error:
+		return "major"
+	case config.Warning:
+		return "minor"
+	}
+
+	return "info"
+}
+
+// Write the report as a GitLab Code Quality report, with an issue for each
+// highlighted line. Failures without highlights are anchored at the first
+// line of the file the rule is about, or of the content path. Rules which
+// couldn't be evaluated are left out if the error isn't about a file.
+func writeGitlab(w io.Writer, report *Report) error {
+	issues := []gitlabIssue{}
+	seen := occurrences{}
+
+	for _, result := range report.failures() {
+		info, _ := report.rule(result.Id)
+
+		if result.FileHighlights == nil || len(*result.FileHighlights) == 0 || result.Error != nil {
+			file, line := anchorFile(info), 1
+			if result.Error != nil {
+				file, line = errorLocation(info, result.Error)
+				// Problems in the rule set, like an invalid regex, aren't
+				// about any content file
+				if file == "" {
+					continue
+				}
+				if line < 1 {
+					line = 1
+				}
+			}
+			issue := gitlabIssue{
+				Description: result.Description,
+				CheckName:   result.Id,
+				Fingerprint: fingerprint(result.Id, file, "", seen.next(result.Id, file, "")),
+				Severity:    gitlabSeverity(result.Level),
+				Location: gitlabLocation{
+					Path:  report.workspacePath(file),
+					Lines: gitlabLines{Begin: line},
+				},
+			}
+			if result.Error != nil {
+				issue.Description = fmt.Sprintf("%s could not be evaluated: %s", result.Id, result.Error.Error())
+				issue.Severity = "critical"
+			}
+			issues = append(issues, issue)
+			continue
+		}
+
+		for _, highlight := range *result.FileHighlights {
+			file := filepath.ToSlash(highlight.Path)
+			// Path highlights have no line, and GitLab lines start at 1
+			lines := gitlabLines{Begin: 1}
+			if highlight.LineNumber > 0 {
+				lines.Begin = highlight.LineNumber
+				if highlight.LineCount > 1 {
+					lines.End = highlight.LineNumber + highlight.LineCount - 1
+				}
+			}
+
+			issues = append(issues, gitlabIssue{
+				Description: highlightMessage(result, highlight),
+				CheckName:   result.Id,
+				Fingerprint: fingerprint(result.Id, file, highlight.LineContent, seen.next(result.Id, file, highlight.LineContent)),
+				Severity:    gitlabSeverity(result.Level),
+				Location: gitlabLocation{
+					Path:  report.workspacePath(file),
+					Lines: lines,
+				},
+			})
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(issues)
+}
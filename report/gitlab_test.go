@@ -0,0 +1,137 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/linter"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestWriteGitlab(t *testing.T) {
+	report := testReport()
+	structRules := (*report.RuleSet.RuleGroups)["struct"].Rules
+	readme := "./README.md"
+	(*structRules)[0].Conditions = &[]config.Condition{{PathExists: &readme}}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "gitlab", report); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	got := []gitlabIssue{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	want := []gitlabIssue{
+		{
+			Description: "Links should have alternative text",
+			CheckName:   "link_1",
+			Severity:    "minor",
+			Location:    gitlabLocation{Path: "blueprint/index.md", Lines: gitlabLines{Begin: 3}},
+		},
+		{
+			Description: "Links should have alternative text: no title",
+			CheckName:   "link_1",
+			Severity:    "minor",
+			Location:    gitlabLocation{Path: "blueprint/index.md", Lines: gitlabLines{Begin: 9, End: 10}},
+		},
+		{
+			Description: "The README.md must exist",
+			CheckName:   "struct_0",
+			Severity:    "major",
+			Location:    gitlabLocation{Path: "README.md", Lines: gitlabLines{Begin: 1}},
+		},
+		{
+			Description: "struct_1 could not be evaluated: file-not-found: open schemas/config.schema.json: no such file or directory",
+			CheckName:   "struct_1",
+			Severity:    "critical",
+			Location:    gitlabLocation{Path: "schemas/config.schema.json", Lines: gitlabLines{Begin: 1}},
+		},
+	}
+	if !cmp.Equal(got, want, cmpopts.IgnoreFields(gitlabIssue{}, "Fingerprint")) {
+		t.Errorf("%v", cmp.Diff(got, want, cmpopts.IgnoreFields(gitlabIssue{}, "Fingerprint")))
+	}
+
+	fingerprints := map[string]bool{}
+	for _, issue := range got {
+		if fingerprints[issue.Fingerprint] {
+			t.Errorf("duplicate fingerprint %s", issue.Fingerprint)
+		}
+		fingerprints[issue.Fingerprint] = true
+	}
+
+	// Fingerprints don't change when the highlighted line moves
+	(*(*report.Result.FailureResults)[2].FileHighlights)[0].LineNumber = 5
+	var moved bytes.Buffer
+	if err := Write(&moved, "gitlab", report); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	movedIssues := []gitlabIssue{}
+	if err := json.Unmarshal(moved.Bytes(), &movedIssues); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if movedIssues[0].Fingerprint != got[0].Fingerprint {
+		t.Errorf("fingerprint changed when the line moved")
+	}
+}
+
+func TestWriteGitlab_Path(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, "gitlab", testPathReport()); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	got := []gitlabIssue{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	want := []gitlabIssue{
+		{
+			Description: "Private files must not be committed: path must not exist",
+			CheckName:   "struct_0",
+			Severity:    "major",
+			Location:    gitlabLocation{Path: "blueprint/.env", Lines: gitlabLines{Begin: 1}},
+		},
+	}
+	if !cmp.Equal(got, want, cmpopts.IgnoreFields(gitlabIssue{}, "Fingerprint")) {
+		t.Errorf("%v", cmp.Diff(got, want, cmpopts.IgnoreFields(gitlabIssue{}, "Fingerprint")))
+	}
+}
+
+// Errors which aren't about a file have no location in the content
+func TestWriteGitlab_RuleError(t *testing.T) {
+	report := testPathReport()
+	*report.Result.FailureResults = append(*report.Result.FailureResults, linter.RuleResult{
+		Id:          "struct_1",
+		Level:       config.Error,
+		Description: "Names must match",
+		Error: &linter.ValidationError{
+			RuleId: "struct_1",
+			Code:   linter.InvalidRegexCode,
+			Err:    errors.New("missing closing )"),
+		},
+	})
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "gitlab", report); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	got := []gitlabIssue{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	for _, issue := range got {
+		if issue.CheckName == "struct_1" {
+			t.Errorf("rule error without a file is reported at %s", issue.Location.Path)
+		}
+	}
+	if len(got) != 1 {
+		t.Errorf("%d issues, want 1", len(got))
+	}
+}
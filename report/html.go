@@ -0,0 +1,316 @@
+package report
+
+import (
+	_ "embed"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/linter"
+)
+
+//go:embed html.tmpl
+var htmlTemplate string
+
+// Statuses of the rules, which the report can be filtered by. Failed rules
+// have the status of their level.
+const (
+	htmlPassed       = "passed"
+	htmlNotEvaluated = "not-evaluated"
+	htmlNotice       = "notice"
+)
+
+type htmlData struct {
+	Title       string
+	Description string
+	Source      string
+	Generated   string
+	Duration    string
+	Counts      resultCounts
+	Total       int
+	Chart       []htmlSlice
+	Groups      []htmlGroup
+	Statuses    []htmlStatus
+	Rules       []htmlRule
+}
+
+// A segment of the summary donut chart
+type htmlSlice struct {
+	Status string
+	Label  string
+	Count  int
+	Dash   string // stroke-dasharray of the segment, the circle has a length of 100
+	Offset string // stroke-dashoffset of the segment
+}
+
+type htmlGroup struct {
+	Name        string
+	Description string
+	Bars        []htmlBar
+	Total       int
+}
+
+// A segment of the stacked bar of a group
+type htmlBar struct {
+	Status string
+	Count  int
+	Width  string // percentage of the rules of the group
+}
+
+type htmlStatus struct {
+	Status  string
+	Label   string
+	Count   int
+	Checked bool
+}
+
+type htmlRule struct {
+	Id          string
+	Group       string
+	Status      string
+	Level       string
+	Description string
+	Remediation string
+	Error       string
+	File        string // file a failure without excerpts is about
+	Excerpts    []htmlExcerpt
+}
+
+type htmlExcerpt struct {
+	File    string
+	Line    int
+	Message string
+	Lines   []htmlLine
+}
+
+type htmlLine struct {
+	Number      int
+	Highlighted bool
+	Before      string
+	Mark        string // the matched range of a highlighted line
+	After       string
+}
+
+// Write the report as a single HTML page which works offline, for attaching
+// to reviews: summary charts, filters by status and group, and each failed
+// rule with excerpts of the highlighted lines.
+func writeHtml(w io.Writer, report *Report) error {
+	tmpl, err := template.New("report").Parse(htmlTemplate)
+	if err != nil {
+		return err
+	}
+
+	return tmpl.Execute(w, newHtmlData(report))
+}
+
+func newHtmlData(report *Report) *htmlData {
+	data := &htmlData{
+		Title:  toolName,
+		Source: report.ContentPath,
+		Counts: report.counts(),
+	}
+	if report.RuleSet != nil {
+		if report.RuleSet.Name != "" {
+			data.Title = report.RuleSet.Name
+		}
+		data.Description = report.RuleSet.Description
+	}
+	if !report.Result.StartTime.IsZero() {
+		data.Generated = report.Result.StartTime.Format(time.RFC1123)
+		data.Duration = report.Result.Duration.Round(time.Millisecond).String()
+	}
+
+	results := map[string]linter.RuleResult{}
+	for _, result := range append(report.successes(), report.failures()...) {
+		results[strings.ToLower(result.Id)] = result
+	}
+
+	lines := map[string][]string{}
+	statusCounts := map[string]int{}
+	groupCounts := map[string]map[string]int{}
+	for _, info := range report.rules() {
+		result, ok := results[strings.ToLower(info.Id)]
+		if !ok {
+			continue
+		}
+
+		rule := htmlRule{
+			Id:          info.Id,
+			Group:       strings.ToUpper(info.Group),
+			Status:      htmlStatusOf(result),
+			Level:       htmlLevel(result.Level),
+			Description: strings.Join(strings.Fields(result.Description), " "),
+			Remediation: info.Rule.Remediation,
+		}
+		switch {
+		case result.Error != nil:
+			rule.Error = result.Error.Error()
+			rule.File, _ = errorLocation(info, result.Error)
+		case result.IsSuccess:
+		case result.FileHighlights == nil || len(*result.FileHighlights) == 0:
+			rule.File = anchorFile(info)
+		default:
+			for _, highlight := range *result.FileHighlights {
+				file := filepath.ToSlash(highlight.Path)
+				fileLines := []string{}
+				if !isSensitive(info, highlight) {
+					if _, ok := lines[file]; !ok {
+						lines[file] = report.readLines(file)
+					}
+					fileLines = lines[file]
+				}
+				rule.Excerpts = append(rule.Excerpts, htmlExcerpt{
+					File:    file,
+					Line:    highlight.LineNumber,
+					Message: highlight.Message,
+					Lines:   htmlExcerptLines(fileLines, highlight),
+				})
+			}
+		}
+
+		if len(data.Groups) == 0 || data.Groups[len(data.Groups)-1].Name != rule.Group {
+			data.Groups = append(data.Groups, htmlGroup{Name: rule.Group, Description: info.GroupDescription})
+			groupCounts[rule.Group] = map[string]int{}
+		}
+		data.Groups[len(data.Groups)-1].Total++
+		groupCounts[rule.Group][rule.Status]++
+		statusCounts[rule.Status]++
+		data.Rules = append(data.Rules, rule)
+	}
+
+	// Failures first in the legend and the filters
+	statuses := []string{string(config.Error), string(config.Warning), htmlNotice, htmlNotEvaluated, htmlPassed}
+	labels := map[string]string{
+		string(config.Error):   "Errors",
+		string(config.Warning): "Warnings",
+		htmlNotice:             "Notices",
+		htmlNotEvaluated:       "Not evaluated",
+		htmlPassed:             "Passed",
+	}
+
+	data.Total = len(data.Rules)
+	offset := 25.0 // start at the top of the circle
+	for _, status := range statuses {
+		count := statusCounts[status]
+		data.Statuses = append(data.Statuses, htmlStatus{
+			Status:  status,
+			Label:   labels[status],
+			Count:   count,
+			Checked: status != htmlPassed || len(data.Rules) == statusCounts[htmlPassed],
+		})
+		if count == 0 {
+			continue
+		}
+
+		length := 100 * float64(count) / float64(data.Total)
+		data.Chart = append(data.Chart, htmlSlice{
+			Status: status,
+			Label:  labels[status],
+			Count:  count,
+			Dash:   fmt.Sprintf("%.2f %.2f", length, 100-length),
+			Offset: fmt.Sprintf("%.2f", math.Mod(offset+100, 100)),
+		})
+		offset -= length
+	}
+
+	for i, group := range data.Groups {
+		for _, status := range statuses {
+			count := groupCounts[group.Name][status]
+			if count == 0 {
+				continue
+			}
+			data.Groups[i].Bars = append(data.Groups[i].Bars, htmlBar{
+				Status: status,
+				Count:  count,
+				Width:  fmt.Sprintf("%.2f%%", 100*float64(count)/float64(group.Total)),
+			})
+		}
+	}
+
+	return data
+}
+
+func htmlStatusOf(result linter.RuleResult) string {
+	switch {
+	case result.Error != nil:
+		return htmlNotEvaluated
+	case result.IsSuccess:
+		return htmlPassed
+	}
+
+	return htmlLevel(result.Level)
+}
+
+func htmlLevel(level config.Level) string {
+	if level == config.Error || level == config.Warning {
+		return string(level)
+	}
+
+	return htmlNotice
+}
+
+// Get the highlighted lines with the lines around them. If the file doesn't
+// have the content the rule reported, like a redacted secret, only the
+// reported content is shown. Sensitive highlights get no lines of the file, so
+// only their content is shown too. Path highlights have no lines.
+func htmlExcerptLines(lines []string, highlight linter.FileHighlight) []htmlLine {
+	if highlight.LineNumber < 1 {
+		return []htmlLine{}
+	}
+
+	lineCount := highlight.LineCount
+	if lineCount < 1 {
+		lineCount = 1
+	}
+	lastLine := highlight.LineNumber + lineCount - 1
+
+	if highlight.LineNumber > len(lines) || strings.TrimSpace(lines[highlight.LineNumber-1]) != strings.TrimSpace(highlight.LineContent) {
+		return []htmlLine{{Number: highlight.LineNumber, Highlighted: true, Mark: highlight.LineContent}}
+	}
+
+	first, last := highlight.LineNumber-frameContext, lastLine+frameContext
+	if first < 1 {
+		first = 1
+	}
+	if last > len(lines) {
+		last = len(lines)
+	}
+
+	ret := []htmlLine{}
+	for number := first; number <= last; number++ {
+		line := htmlLine{Number: number, Before: lines[number-1]}
+		if number >= highlight.LineNumber && number <= lastLine {
+			line.Highlighted = true
+			if number == highlight.LineNumber && lineCount == 1 && highlight.Column > 0 {
+				line.Before, line.Mark, line.After = splitColumns(lines[number-1], highlight.Column, highlight.EndColumn)
+			}
+		}
+		ret = append(ret, line)
+	}
+
+	return ret
+}
+
+// Split the line around the range of the columns, which are 1-based and
+// count runes. Without an end column the range is the rest of the line.
+func splitColumns(line string, column int, endColumn int) (string, string, string) {
+	runes := []rune(line)
+	start := column - 1
+	if start > len(runes) {
+		start = len(runes)
+	}
+	end := endColumn - 1
+	if endColumn < 1 || end > len(runes) {
+		end = len(runes)
+	}
+	if end < start {
+		end = start
+	}
+
+	return string(runes[:start]), string(runes[start:end]), string(runes[end:])
+}
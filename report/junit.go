@@ -0,0 +1,176 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/linter"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitProblem `xml:"failure,omitempty"`
+	Error     *junitProblem `xml:"error,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitProblem struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// Write the report as JUnit XML. Each rule group is a test suite and each rule
+// a test case. Failed rules are failures, rules which couldn't be evaluated
+// are errors and disabled rules are skipped.
+func writeJunit(w io.Writer, report *Report) error {
+	results := map[string]linter.RuleResult{}
+	for _, result := range append(report.successes(), report.failures()...) {
+		results[strings.ToLower(result.Id)] = result
+	}
+
+	suites := &junitTestSuites{
+		Name: toolName,
+		Time: junitSeconds(report.Result.Duration),
+	}
+	entries := []config.RuleEntry{}
+	if report.RuleSet != nil {
+		if report.RuleSet.Name != "" {
+			suites.Name = report.RuleSet.Name
+		}
+		entries = report.RuleSet.Entries()
+	}
+	timestamp := ""
+	if !report.Result.StartTime.IsZero() {
+		timestamp = report.Result.StartTime.Format("2006-01-02T15:04:05")
+	}
+
+	var suite *junitTestSuite
+	var suiteDuration time.Duration
+	for _, entry := range entries {
+		if suite == nil || suite.Name != entry.Group {
+			if suite != nil {
+				suite.Time = junitSeconds(suiteDuration)
+				suites.Suites = append(suites.Suites, *suite)
+			}
+			suite = &junitTestSuite{Name: entry.Group, Timestamp: timestamp}
+			suiteDuration = 0
+		}
+
+		testCase := junitTestCase{
+			Name:      entry.Id,
+			Classname: entry.Group,
+			Time:      junitSeconds(0),
+			SystemOut: strings.Join(strings.Fields(entry.Rule.Description), " "),
+		}
+		result, evaluated := results[strings.ToLower(entry.Id)]
+		switch {
+		case entry.Rule.Disabled:
+			testCase.Skipped = &junitSkipped{Message: "rule is disabled"}
+			suite.Skipped++
+		case !evaluated:
+			testCase.Skipped = &junitSkipped{Message: "rule wasn't evaluated"}
+			suite.Skipped++
+		case result.Error != nil:
+			testCase.Error = &junitProblem{
+				Message: result.Error.Err.Error(),
+				Type:    string(result.Error.Code),
+				Text:    result.Description,
+			}
+			suite.Errors++
+		case !result.IsSuccess:
+			testCase.Failure = &junitProblem{
+				Message: result.Description,
+				Type:    string(result.Level),
+				Text:    junitHighlights(result),
+			}
+			suite.Failures++
+		}
+		if evaluated {
+			testCase.Time = junitSeconds(result.Duration)
+			suiteDuration += result.Duration
+		}
+
+		suite.Tests++
+		suite.Cases = append(suite.Cases, testCase)
+	}
+	if suite != nil {
+		suite.Time = junitSeconds(suiteDuration)
+		suites.Suites = append(suites.Suites, *suite)
+	}
+
+	for _, s := range suites.Suites {
+		suites.Tests += s.Tests
+		suites.Failures += s.Failures
+		suites.Errors += s.Errors
+		suites.Skipped += s.Skipped
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
+
+// List the highlighted lines of the failure, one per line
+func junitHighlights(result linter.RuleResult) string {
+	if result.FileHighlights == nil {
+		return ""
+	}
+
+	lines := []string{}
+	for _, highlight := range *result.FileHighlights {
+		line := fmt.Sprintf("%s:%d: %s", filepath.ToSlash(highlight.Path), highlight.LineNumber, strings.TrimSpace(highlight.LineContent))
+		if highlight.Message != "" {
+			line += " (" + highlight.Message + ")"
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func junitSeconds(duration time.Duration) string {
+	return fmt.Sprintf("%.6f", duration.Seconds())
+}
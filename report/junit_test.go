@@ -0,0 +1,115 @@
+package report
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWriteJunit(t *testing.T) {
+	report := testReport()
+	(*report.Result.FailureResults)[1].Duration = 1500 * time.Microsecond
+	report.Result.Duration = 2 * time.Millisecond
+	(*report.RuleSet.RuleGroups)["link"] = config.RuleGroup{
+		Description: "Links",
+		Rules: &[]config.Rule{
+			(*(*report.RuleSet.RuleGroups)["link"].Rules)[0],
+			(*(*report.RuleSet.RuleGroups)["link"].Rules)[1],
+			{Description: "Links must use HTTPS", Level: config.Error, Disabled: true},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "junit", report); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	got := junitTestSuites{}
+	if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if got.Tests != 5 || got.Failures != 2 || got.Errors != 1 || got.Skipped != 1 || got.Time != "0.002000" {
+		t.Errorf("totals: %d tests, %d failures, %d errors, %d skipped in %s", got.Tests, got.Failures, got.Errors, got.Skipped, got.Time)
+	}
+
+	type testCase struct {
+		Suite   string
+		Name    string
+		Time    string
+		Outcome string
+		Message string
+		Text    string
+	}
+	cases := []testCase{}
+	for _, suite := range got.Suites {
+		for _, c := range suite.Cases {
+			tc := testCase{Suite: suite.Name, Name: c.Name, Time: c.Time, Outcome: "passed"}
+			switch {
+			case c.Failure != nil:
+				tc.Outcome, tc.Message, tc.Text = "failure", c.Failure.Message, c.Failure.Text
+			case c.Error != nil:
+				tc.Outcome, tc.Message, tc.Text = "error", c.Error.Message, c.Error.Text
+			case c.Skipped != nil:
+				tc.Outcome, tc.Message = "skipped", c.Skipped.Message
+			}
+			cases = append(cases, tc)
+		}
+	}
+	want := []testCase{
+		{Suite: "link", Name: "link_0", Time: "0.000000", Outcome: "passed"},
+		{
+			Suite:   "link",
+			Name:    "link_1",
+			Time:    "0.000000",
+			Outcome: "failure",
+			Message: "Links should have alternative text",
+			Text:    "blueprint/index.md:3: [Docs](https://example.com)\nblueprint/index.md:9: [Home](https://example.com) (no title)",
+		},
+		{Suite: "link", Name: "link_2", Time: "0.000000", Outcome: "skipped", Message: "rule is disabled"},
+		{Suite: "struct", Name: "struct_0", Time: "0.001500", Outcome: "failure", Message: "The README.md must exist"},
+		{Suite: "struct", Name: "struct_1", Time: "0.000000", Outcome: "error", Message: "open schemas/config.schema.json: no such file or directory", Text: "The schema must be valid"},
+	}
+	if !cmp.Equal(cases, want) {
+		t.Errorf("%v", cmp.Diff(cases, want))
+	}
+}
+
+func TestWriteJunit_NoRuleSet(t *testing.T) {
+	report := testReport()
+	report.RuleSet = nil
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "junit", report); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	got := junitTestSuites{}
+	if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if got.Name != toolName || len(got.Suites) != 0 {
+		t.Errorf("got %q with %d suites, want %q without suites", got.Name, len(got.Suites), toolName)
+	}
+}
+
+func TestWriteJunit_NoName(t *testing.T) {
+	report := testReport()
+	report.RuleSet.Name = ""
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "junit", report); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	got := junitTestSuites{}
+	if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if got.Name != toolName || len(got.Suites) != 2 {
+		t.Errorf("got %q with %d suites, want %q with 2 suites", got.Name, len(got.Suites), toolName)
+	}
+}
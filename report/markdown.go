@@ -0,0 +1,202 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/linter"
+)
+
+// Counts of the rules of a group by outcome
+type groupCounts struct {
+	group string
+	resultCounts
+}
+
+// Write the report in Markdown for pull request comments: a summary table,
+// and a collapsible section for each rule group with the failures and
+// snippets of the highlighted lines. Sections with failures are open.
+func writeMarkdown(w io.Writer, report *Report) error {
+	var sb strings.Builder
+
+	title := toolName
+	if report.RuleSet != nil && report.RuleSet.Name != "" {
+		title = fmt.Sprintf("%s: %s", toolName, report.RuleSet.Name)
+	}
+	fmt.Fprintf(&sb, "## %s\n\n", title)
+
+	results := map[string]linter.RuleResult{}
+	for _, result := range append(report.successes(), report.failures()...) {
+		results[strings.ToLower(result.Id)] = result
+	}
+
+	// Rules in the order of the rule set, grouped
+	groups := []groupCounts{}
+	groupRules := map[string][]ruleInfo{}
+	for _, info := range report.rules() {
+		if len(groups) == 0 || groups[len(groups)-1].group != info.Group {
+			groups = append(groups, groupCounts{group: info.Group})
+		}
+		counts := &groups[len(groups)-1].resultCounts
+		groupRules[info.Group] = append(groupRules[info.Group], info)
+
+		result, ok := results[strings.ToLower(info.Id)]
+		switch {
+		case !ok:
+		case result.Error != nil:
+			counts.notEvaluated++
+		case result.IsSuccess:
+			counts.passed++
+		case result.Level == config.Error:
+			counts.errors++
+		default:
+			counts.warnings++
+		}
+	}
+
+	total := report.counts()
+	sb.WriteString("| Group | Passed | Errors | Warnings | Not evaluated |\n")
+	sb.WriteString("| --- | ---: | ---: | ---: | ---: |\n")
+	for _, group := range groups {
+		fmt.Fprintf(&sb, "| %s | %d | %d | %d | %d |\n", strings.ToUpper(group.group), group.passed, group.errors, group.warnings, group.notEvaluated)
+	}
+	fmt.Fprintf(&sb, "| **Total** | **%d** | **%d** | **%d** | **%d** |\n", total.passed, total.errors, total.warnings, total.notEvaluated)
+
+	for _, group := range groups {
+		failed := group.errors + group.warnings + group.notEvaluated
+		open := ""
+		if failed > 0 {
+			open = " open"
+		}
+		description := ""
+		if infos := groupRules[group.group]; len(infos) > 0 && infos[0].GroupDescription != "" {
+			description = ": " + html.EscapeString(infos[0].GroupDescription)
+		}
+		status := "all passed"
+		if failed > 0 {
+			status = fmt.Sprintf("%d failed", failed)
+		}
+
+		fmt.Fprintf(&sb, "\n<details%s>\n<summary><b>%s</b>%s (%s)</summary>\n\n", open, strings.ToUpper(group.group), description, status)
+
+		passed := []string{}
+		for _, info := range groupRules[group.group] {
+			result, ok := results[strings.ToLower(info.Id)]
+			if !ok {
+				continue
+			}
+			if result.IsSuccess && result.Error == nil {
+				passed = append(passed, "`"+info.Id+"`")
+				continue
+			}
+			writeMarkdownFailure(&sb, report, info, result)
+		}
+		if len(passed) > 0 {
+			fmt.Fprintf(&sb, "Passed: %s\n\n", strings.Join(passed, ", "))
+		}
+
+		sb.WriteString("</details>\n")
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeMarkdownFailure(sb *strings.Builder, report *Report, info ruleInfo, result linter.RuleResult) {
+	description := strings.Join(strings.Fields(result.Description), " ")
+	if result.Error != nil {
+		fmt.Fprintf(sb, "#### `%s` not evaluated\n\n%s\n\n", result.Id, description)
+		fmt.Fprintf(sb, "> `%s` %s\n\n", result.Error.Code, strings.ReplaceAll(result.Error.Err.Error(), "\n", " "))
+		if file, line := errorLocation(info, result.Error); file != "" {
+			location := file
+			if line > 0 {
+				location = fmt.Sprintf("%s:%d", file, line)
+			}
+			fmt.Fprintf(sb, "- [`%s`](%s)\n\n", location, report.fileLink(file, line))
+		}
+		return
+	}
+
+	fmt.Fprintf(sb, "#### `%s` %s\n\n%s\n\n", result.Id, result.Level, description)
+	if info.Rule.Remediation != "" {
+		fmt.Fprintf(sb, "**How to fix:** %s\n\n", info.Rule.Remediation)
+	}
+
+	if result.FileHighlights == nil || len(*result.FileHighlights) == 0 {
+		writeMarkdownAnchor(sb, report, info)
+		return
+	}
+
+	for _, highlight := range *result.FileHighlights {
+		file := filepath.ToSlash(highlight.Path)
+		location := file
+		if highlight.LineNumber > 0 {
+			location = fmt.Sprintf("%s:%d", file, highlight.LineNumber)
+		}
+		message := ""
+		if highlight.Message != "" {
+			message = " (" + highlight.Message + ")"
+		}
+		fmt.Fprintf(sb, "- [`%s`](%s)%s\n", location, report.fileLink(file, highlight.LineNumber), message)
+		if highlight.LineContent != "" {
+			// Every line of the block is indented to stay in the list item
+			fence := markdownFence(highlight.LineContent)
+			content := strings.ReplaceAll(strings.ReplaceAll(highlight.LineContent, "\r\n", "\n"), "\n", "\n  ")
+			fmt.Fprintf(sb, "\n  %s\n  %s\n  %s\n", fence, content, fence)
+		}
+		sb.WriteString("\n")
+	}
+}
+
+// Link the file a failure without highlights is about
+func writeMarkdownAnchor(sb *strings.Builder, report *Report, info ruleInfo) {
+	if file := anchorFile(info); file != "" {
+		fmt.Fprintf(sb, "- [`%s`](%s)\n\n", file, report.fileLink(file, 0))
+	}
+}
+
+// Get the link to the line of the content file. For remote repositories
+// it's the URL of the file, otherwise the path relative to the working
+// directory.
+func (report *Report) fileLink(file string, line int) string {
+	link := (&url.URL{Path: report.workspacePath(file)}).EscapedPath()
+	if report.ContentPath != "" {
+		localPath := filepath.Join(report.ContentPath, filepath.FromSlash(file))
+		if original := blueprintrepo.GetOriginalRelPath(localPath); original != localPath {
+			link = original
+		}
+	}
+
+	if line > 0 {
+		link += fmt.Sprintf("#L%d", line)
+	}
+
+	return link
+}
+
+// Get a code fence longer than any run of backticks in the content
+func markdownFence(content string) string {
+	longest, run := 0, 0
+	for _, r := range content {
+		if r == '`' {
+			run++
+			if run > longest {
+				longest = run
+			}
+			continue
+		}
+		run = 0
+	}
+
+	if longest < 3 {
+		return "```"
+	}
+
+	return strings.Repeat("`", longest+1)
+}
@@ -0,0 +1,77 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/linter"
+)
+
+func TestWriteMarkdown(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, "markdown", testReport()); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	for _, part := range []string{
+		"## gc-linter: Test Rules\n",
+		"| LINK | 1 | 0 | 1 | 0 |\n| STRUCT | 0 | 1 | 0 | 1 |\n| **Total** | **1** | **1** | **1** | **1** |\n",
+		"<details open>\n<summary><b>LINK</b>: Links (1 failed)</summary>\n",
+		"- [`blueprint/index.md:9`](blueprint/index.md#L9) (no title)\n\n  ```\n  [Home](https://example.com)\n  ```\n",
+		"Passed: `link_0`\n",
+		"#### `struct_1` not evaluated\n\nThe schema must be valid\n\n> `file-not-found` open schemas/config.schema.json: no such file or directory\n\n- [`schemas/config.schema.json`](schemas/config.schema.json)\n",
+	} {
+		if !strings.Contains(buf.String(), part) {
+			t.Errorf("output doesn't contain %q:\n%s", part, buf.String())
+		}
+	}
+}
+
+func TestWriteMarkdown_Path(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, "markdown", testPathReport()); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	want := "- [`blueprint/.env`](blueprint/.env) (path must not exist)\n\n"
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("output doesn't contain %q:\n%s", want, buf.String())
+	}
+}
+
+// Highlights of several lines, like a regex match, stay in the list item
+func TestWriteMarkdown_MultiLine(t *testing.T) {
+	report := testPathReport()
+	(*report.Result.FailureResults)[0].FileHighlights = &[]linter.FileHighlight{
+		{Path: "blueprint/index.md", LineNumber: 2, LineCount: 3, LineContent: "---\r\ntitle: Test\n---"},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "markdown", report); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	want := "- [`blueprint/index.md:2`](blueprint/index.md#L2)\n\n  ```\n  ---\n  title: Test\n  ---\n  ```\n\n"
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("output doesn't contain %q:\n%s", want, buf.String())
+	}
+}
+
+func TestMarkdownFence(t *testing.T) {
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{"plain", "```"},
+		{"`code` and ``more``", "```"},
+		{"```go", "````"},
+		{"a ````` b", "``````"},
+	}
+
+	for _, test := range tests {
+		if got := markdownFence(test.content); got != test.want {
+			t.Errorf("markdownFence(%q) = %q, want %q", test.content, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,349 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+)
+
+// Lines shown before and after a highlighted line
+const frameContext = 2
+
+const tabWidth = 4
+
+// ANSI escape codes
+const (
+	colorReset  = "\x1b[0m"
+	colorBold   = "\x1b[1m"
+	colorDim    = "\x1b[2m"
+	colorRed    = "\x1b[31m"
+	colorGreen  = "\x1b[32m"
+	colorYellow = "\x1b[33m"
+	colorCyan   = "\x1b[36m"
+)
+
+// A failure at a location in the content
+type prettyFinding struct {
+	file      string // empty if the failure isn't about a file
+	line      int    // 0 if the failure isn't about a line
+	lineCount int
+	column    int
+	endColumn int
+	content   string // content of the highlighted line as reported by the rule
+	sensitive bool   // only the content may be shown, not the file around it
+	level     config.Level
+	ruleId    string
+	message   string
+}
+
+type prettyWriter struct {
+	report *Report
+	color  bool
+	files  map[string][]string // lines of the content files, empty if unreadable
+}
+
+// Write the report for reading in a terminal: the failures grouped by file
+// with a code frame around each highlighted line, and a summary line. Colors
+// are only used if the report enables them.
+func writePretty(w io.Writer, report *Report) error {
+	p := &prettyWriter{
+		report: report,
+		color:  report.Color,
+		files:  map[string][]string{},
+	}
+
+	findings := []prettyFinding{}
+	notEvaluated := []string{}
+	for _, result := range report.failures() {
+		if result.Error != nil {
+			info, _ := report.rule(result.Id)
+			location := ""
+			if file, line := errorLocation(info, result.Error); file != "" {
+				location = "  " + file
+				if line > 0 {
+					location += fmt.Sprintf(":%d", line)
+				}
+			}
+			notEvaluated = append(notEvaluated, fmt.Sprintf("%s  %s%s", p.paint(colorBold, result.Id), result.Error.Error(), p.paint(colorDim, location)))
+			continue
+		}
+
+		if result.FileHighlights == nil || len(*result.FileHighlights) == 0 {
+			info, _ := report.rule(result.Id)
+			findings = append(findings, prettyFinding{
+				file:    anchorFile(info),
+				level:   result.Level,
+				ruleId:  result.Id,
+				message: result.Description,
+			})
+			continue
+		}
+
+		info, _ := report.rule(result.Id)
+		for _, highlight := range *result.FileHighlights {
+			findings = append(findings, prettyFinding{
+				file:      filepath.ToSlash(highlight.Path),
+				line:      highlight.LineNumber,
+				lineCount: highlight.LineCount,
+				column:    highlight.Column,
+				endColumn: highlight.EndColumn,
+				content:   highlight.LineContent,
+				sensitive: isSensitive(info, highlight),
+				level:     result.Level,
+				ruleId:    result.Id,
+				message:   highlightMessage(result, highlight),
+			})
+		}
+	}
+
+	// Failures which aren't about a file go last
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if (a.file == "") != (b.file == "") {
+			return b.file == ""
+		}
+		if a.file != b.file {
+			return a.file < b.file
+		}
+		return a.line < b.line
+	})
+
+	var sb strings.Builder
+	for i, finding := range findings {
+		if i == 0 || finding.file != findings[i-1].file {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			title := finding.file
+			if title == "" {
+				title = "(content)"
+			}
+			sb.WriteString(p.paint(colorBold, title) + "\n")
+		}
+		p.writeFinding(&sb, finding)
+	}
+
+	if len(notEvaluated) > 0 {
+		if len(findings) > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(p.paint(colorBold, "Rules which couldn't be evaluated") + "\n")
+		for _, line := range notEvaluated {
+			sb.WriteString("  " + line + "\n")
+		}
+	}
+
+	if len(findings) > 0 || len(notEvaluated) > 0 {
+		sb.WriteString("\n")
+	}
+	sb.WriteString(p.summary() + "\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func (p *prettyWriter) writeFinding(sb *strings.Builder, finding prettyFinding) {
+	levelColor := prettyLevelColor(finding.level)
+	level := string(finding.level)
+	if level == "" {
+		level = "notice"
+	}
+
+	location := ""
+	if finding.line > 0 {
+		location = fmt.Sprint(finding.line)
+		if finding.column > 0 {
+			location += fmt.Sprintf(":%d", finding.column)
+		}
+		location += "  "
+	}
+	fmt.Fprintf(sb, "  %s%s  %s %s\n", location, p.paint(levelColor, level), finding.message, p.paint(colorDim, finding.ruleId))
+
+	if finding.line == 0 {
+		return
+	}
+	p.writeFrame(sb, finding, levelColor)
+}
+
+// Write the highlighted lines with the lines around them. The matched range
+// is underlined if the highlight has a column.
+func (p *prettyWriter) writeFrame(sb *strings.Builder, finding prettyFinding, levelColor string) {
+	lineCount := finding.lineCount
+	if lineCount < 1 {
+		lineCount = 1
+	}
+	lastLine := finding.line + lineCount - 1
+
+	// The file may hold secrets, the line isn't in the file, or the rule
+	// reported different content, like a redacted secret: only show what the
+	// rule reported
+	lines := []string{}
+	if !finding.sensitive {
+		lines = p.fileLines(finding.file)
+	}
+	if finding.line > len(lines) || strings.TrimSpace(lines[finding.line-1]) != strings.TrimSpace(finding.content) {
+		gutter := len(fmt.Sprint(finding.line))
+		fmt.Fprintf(sb, "  %s %s %s\n", p.paint(levelColor, ">"), p.paint(colorDim, fmt.Sprintf("%*d |", gutter, finding.line)), expandTabs(finding.content))
+		return
+	}
+
+	first, last := finding.line-frameContext, lastLine+frameContext
+	if first < 1 {
+		first = 1
+	}
+	if last > len(lines) {
+		last = len(lines)
+	}
+
+	gutter := len(fmt.Sprint(last))
+	for number := first; number <= last; number++ {
+		line := lines[number-1]
+		marker := " "
+		if number >= finding.line && number <= lastLine {
+			marker = p.paint(levelColor, ">")
+		}
+		fmt.Fprintf(sb, "  %s %s\n", marker, strings.TrimRight(p.paint(colorDim, fmt.Sprintf("%*d |", gutter, number))+" "+expandTabs(line), " "))
+
+		if number == finding.line && lineCount == 1 {
+			start, end := underlineRange(line, finding.column, finding.endColumn)
+			if end > start {
+				underline := strings.Repeat(" ", start) + strings.Repeat("^", end-start)
+				fmt.Fprintf(sb, "    %s %s\n", p.paint(colorDim, fmt.Sprintf("%*s |", gutter, "")), p.paint(levelColor, underline))
+			}
+		}
+	}
+}
+
+// Get the lines of the content file, which are cached
+func (p *prettyWriter) fileLines(file string) []string {
+	if lines, ok := p.files[file]; ok {
+		return lines
+	}
+
+	lines := p.report.readLines(file)
+	p.files[file] = lines
+
+	return lines
+}
+
+func (p *prettyWriter) summary() string {
+	counts := p.report.counts()
+	problems := counts.errors + counts.warnings
+
+	parts := []string{fmt.Sprintf("%d passed", counts.passed)}
+	if counts.notEvaluated > 0 {
+		parts = append(parts, fmt.Sprintf("%d not evaluated", counts.notEvaluated))
+	}
+
+	if problems == 0 && counts.notEvaluated == 0 {
+		return p.paint(colorGreen, fmt.Sprintf("No problems (%s)", strings.Join(parts, ", ")))
+	}
+
+	summaryColor := colorYellow
+	if counts.errors > 0 || counts.notEvaluated > 0 {
+		summaryColor = colorRed
+	}
+
+	return p.paint(summaryColor, fmt.Sprintf("%d %s (%d %s, %d %s), %s",
+		problems, plural(problems, "failed rule", "failed rules"),
+		counts.errors, plural(counts.errors, "error", "errors"),
+		counts.warnings, plural(counts.warnings, "warning", "warnings"),
+		strings.Join(parts, ", ")))
+}
+
+func (p *prettyWriter) paint(color string, text string) string {
+	if !p.color || text == "" {
+		return text
+	}
+
+	return color + text + colorReset
+}
+
+func prettyLevelColor(level config.Level) string {
+	switch level {
+	case config.Error:
+		return colorRed
+	case config.Warning:
+		return colorYellow
+	}
+
+	return colorCyan
+}
+
+// Get the range to underline in the line with expanded tabs. Without a column
+// the whole line is underlined, without an end column only the character at
+// the column.
+func underlineRange(line string, column int, endColumn int) (int, int) {
+	runes := []rune(line)
+	if column < 1 {
+		trimmed := strings.TrimLeft(line, " \t")
+		start := displayWidth([]rune(line[:len(line)-len(trimmed)]))
+		return start, displayWidth([]rune(strings.TrimRight(line, " \t")))
+	}
+
+	if column > len(runes)+1 {
+		column = len(runes) + 1
+	}
+	if endColumn <= column {
+		endColumn = column + 1
+	}
+	if endColumn > len(runes)+1 {
+		endColumn = len(runes) + 1
+	}
+
+	start := displayWidth(runes[:column-1])
+	end := displayWidth(runes[:endColumn-1])
+	if end == start {
+		// Past the end of the line, like a missing final newline
+		end = start + 1
+	}
+
+	return start, end
+}
+
+// Get the width of the text in the terminal, with tabs expanded
+func displayWidth(runes []rune) int {
+	width := 0
+	for _, r := range runes {
+		if r == '\t' {
+			width += tabWidth - width%tabWidth
+			continue
+		}
+		width++
+	}
+
+	return width
+}
+
+func expandTabs(line string) string {
+	if !strings.Contains(line, "\t") {
+		return line
+	}
+
+	var sb strings.Builder
+	width := 0
+	for _, r := range line {
+		if r == '\t' {
+			spaces := tabWidth - width%tabWidth
+			sb.WriteString(strings.Repeat(" ", spaces))
+			width += spaces
+			continue
+		}
+		sb.WriteRune(r)
+		width++
+	}
+
+	return sb.String()
+}
+
+func plural(count int, singular string, pluralForm string) string {
+	if count == 1 {
+		return singular
+	}
+
+	return pluralForm
+}
@@ -0,0 +1,307 @@
+package report
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/linter"
+)
+
+// Name of the tool in the reports
+const toolName = "gc-linter"
+
+const toolUri = "https://github.com/PrinceMerluza/devcenter-content-linter"
+
+// The validation result with what the formats need to describe it
+type Report struct {
+	Result      *linter.ValidationResult
+	RuleSet     *config.RuleSet
+	ContentPath string // root of the content files, which highlight paths are relative to
+	SummaryPath string // file the github format appends the job summary to
+	Color       bool   // use colors in the pretty format
+	Version     string // version of the linter
+}
+
+// Number of rules by outcome
+type resultCounts struct {
+	passed       int
+	errors       int // failed rules with the error level
+	warnings     int // failed rules with any other level
+	notEvaluated int // rules which couldn't be evaluated
+}
+
+// A rule of the rule set with the description of its group
+type ruleInfo struct {
+	config.RuleEntry
+	GroupDescription string
+}
+
+type writer func(w io.Writer, report *Report) error
+
+// Output formats other than the plain JSON result
+var writers = map[string]writer{
+	"github":   writeGithub,
+	"gitlab":   writeGitlab,
+	"html":     writeHtml,
+	"junit":    writeJunit,
+	"markdown": writeMarkdown,
+	"pretty":   writePretty,
+	"sarif":    writeSarif,
+}
+
+// Get the names of the output formats
+func Formats() []string {
+	ret := []string{}
+	for name := range writers {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+
+	return ret
+}
+
+// Check if there's an output format with the name
+func Exists(format string) bool {
+	_, ok := writers[format]
+	return ok
+}
+
+// Write the report in the format
+func Write(w io.Writer, format string, report *Report) error {
+	write, ok := writers[format]
+	if !ok {
+		return fmt.Errorf("unknown format %s, must be one of: %s", format, strings.Join(Formats(), ", "))
+	}
+
+	return write(w, report)
+}
+
+// Get the enabled rules of the rule set, ordered by group and position
+func (report *Report) rules() []ruleInfo {
+	ret := []ruleInfo{}
+	if report.RuleSet == nil {
+		return ret
+	}
+
+	for _, entry := range report.RuleSet.Entries() {
+		if entry.Rule.Disabled {
+			continue
+		}
+		ret = append(ret, ruleInfo{
+			RuleEntry:        entry,
+			GroupDescription: (*report.RuleSet.RuleGroups)[entry.Group].Description,
+		})
+	}
+
+	return ret
+}
+
+// Find the rule of a result by ID. IDs are case-insensitive.
+func (report *Report) rule(id string) (ruleInfo, bool) {
+	for _, info := range report.rules() {
+		if strings.EqualFold(info.Id, id) {
+			return info, true
+		}
+	}
+
+	return ruleInfo{}, false
+}
+
+// Get the failed rules in the order of the rule set, since the rules are
+// evaluated concurrently
+func (report *Report) failures() []linter.RuleResult {
+	return report.sortResults(report.Result.FailureResults)
+}
+
+// Get the passed rules in the order of the rule set
+func (report *Report) successes() []linter.RuleResult {
+	return report.sortResults(report.Result.SuccessResults)
+}
+
+func (report *Report) sortResults(results *[]linter.RuleResult) []linter.RuleResult {
+	ret := []linter.RuleResult{}
+	if results == nil {
+		return ret
+	}
+	ret = append(ret, *results...)
+
+	positions := map[string]int{}
+	for i, info := range report.rules() {
+		positions[strings.ToLower(info.Id)] = i
+	}
+	position := func(id string) int {
+		if i, ok := positions[strings.ToLower(id)]; ok {
+			return i
+		}
+		return len(positions)
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if position(ret[i].Id) != position(ret[j].Id) {
+			return position(ret[i].Id) < position(ret[j].Id)
+		}
+		return ret[i].Id < ret[j].Id
+	})
+
+	return ret
+}
+
+func (report *Report) counts() resultCounts {
+	ret := resultCounts{passed: len(report.successes())}
+	for _, result := range report.failures() {
+		switch {
+		case result.Error != nil:
+			ret.notEvaluated++
+		case result.Level == config.Error:
+			ret.errors++
+		default:
+			ret.warnings++
+		}
+	}
+
+	return ret
+}
+
+// Get the path of a content file relative to the working directory, which is
+// the root of the repository in CI jobs. Paths outside of the working
+// directory stay relative to the content path. An empty file is the content
+// path itself.
+func (report *Report) workspacePath(file string) string {
+	if file == "" {
+		file = "."
+	}
+	if report.ContentPath == "" {
+		return file
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return file
+	}
+	absPath, err := filepath.Abs(report.ContentPath)
+	if err != nil {
+		return file
+	}
+	relPath, err := filepath.Rel(wd, absPath)
+	if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		return file
+	}
+
+	return path.Join(filepath.ToSlash(relPath), file)
+}
+
+// Read the lines of a content file. Returns no lines if the file can't be
+// read.
+func (report *Report) readLines(file string) []string {
+	lines := []string{}
+	data, err := os.Open(filepath.Join(report.ContentPath, filepath.FromSlash(file)))
+	if err != nil {
+		return lines
+	}
+	defer data.Close()
+
+	scanner := bufio.NewScanner(data)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, strings.ToValidUTF8(scanner.Text(), "\uFFFD"))
+	}
+
+	return lines
+}
+
+// Escape the text for a Markdown table cell
+func markdownCell(text string) string {
+	return strings.NewReplacer("|", "\\|", "\r", "", "\n", " ").Replace(text)
+}
+
+// Get the file a failure of the rule without highlights is about, relative
+// to the content path: the file of the rule, or the path the rule requires.
+// Returns an empty string if the rule checks the whole content.
+func anchorFile(info ruleInfo) string {
+	rule := info.Rule
+	file := ""
+	switch {
+	case rule.File != nil:
+		file = *rule.File
+	case rule.Conditions != nil:
+		for _, condition := range *rule.Conditions {
+			if condition.PathExists != nil {
+				file = *condition.PathExists
+				break
+			}
+			if condition.AllowedEntries != nil {
+				file = condition.AllowedEntries.Path
+				break
+			}
+		}
+	}
+	if file == "" {
+		return ""
+	}
+
+	return path.Clean(strings.TrimPrefix(file, "/"))
+}
+
+// Get the file and line a rule which couldn't be evaluated is about: where the
+// error happened if it's known, else the file of the rule. The line is 0 if
+// it's unknown.
+func errorLocation(info ruleInfo, err *linter.ValidationError) (string, int) {
+	if err.Context.Path != "" {
+		return path.Clean(err.Context.Path), err.Context.Line
+	}
+
+	return anchorFile(info), 0
+}
+
+// Check if the file around the highlighted line must not be shown, since it
+// may hold secrets like the body of a private key
+func isSensitive(info ruleInfo, highlight linter.FileHighlight) bool {
+	if highlight.Sensitive {
+		return true
+	}
+	if info.Rule.Conditions != nil {
+		for _, condition := range *info.Rule.Conditions {
+			if condition.Secrets != nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Get the message of a highlighted line
+func highlightMessage(result linter.RuleResult, highlight linter.FileHighlight) string {
+	if highlight.Message == "" {
+		return result.Description
+	}
+
+	return fmt.Sprintf("%s: %s", result.Description, highlight.Message)
+}
+
+// Get a fingerprint of the finding which doesn't change when lines are added
+// or removed around it. occurrence tells apart identical findings in a file.
+func fingerprint(ruleId string, filePath string, lineContent string, occurrence int) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\x00%s\x00%s\x00%d", strings.ToLower(ruleId), filePath, strings.TrimSpace(lineContent), occurrence)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Counts the occurrences of findings, for fingerprints
+type occurrences map[string]int
+
+func (o occurrences) next(ruleId string, filePath string, lineContent string) int {
+	key := strings.Join([]string{strings.ToLower(ruleId), filePath, strings.TrimSpace(lineContent)}, "\x00")
+	o[key]++
+
+	return o[key]
+}
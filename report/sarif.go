@@ -0,0 +1,334 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifBaseId  = "SRCROOT"
+	// Key of the fingerprint in partialFingerprints
+	sarifFingerprintKey = "gcLinterFindingHash/v1"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool               sarifTool                        `json:"tool"`
+	Invocations        []sarifInvocation                `json:"invocations"`
+	OriginalUriBaseIds map[string]sarifArtifactLocation `json:"originalUriBaseIds,omitempty"`
+	Results            []sarifResult                    `json:"results"`
+	ColumnKind         string                           `json:"columnKind"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationUri string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	Id                   string                 `json:"id"`
+	ShortDescription     sarifMessage           `json:"shortDescription"`
+	FullDescription      sarifMessage           `json:"fullDescription"`
+	Help                 *sarifMessage          `json:"help,omitempty"`
+	DefaultConfiguration sarifConfiguration     `json:"defaultConfiguration"`
+	Properties           map[string]interface{} `json:"properties"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text     string `json:"text"`
+	Markdown string `json:"markdown,omitempty"`
+}
+
+type sarifInvocation struct {
+	ExecutionSuccessful        bool                `json:"executionSuccessful"`
+	ToolExecutionNotifications []sarifNotification `json:"toolExecutionNotifications,omitempty"`
+}
+
+type sarifNotification struct {
+	Level          string              `json:"level"`
+	Message        sarifMessage        `json:"message"`
+	Descriptor     *sarifDescriptorId  `json:"descriptor,omitempty"`
+	AssociatedRule *sarifRuleReference `json:"associatedRule,omitempty"`
+	Locations      []sarifLocation     `json:"locations,omitempty"`
+}
+
+type sarifRuleReference struct {
+	Id    string `json:"id"`
+	Index int    `json:"index"`
+}
+
+// Reference to a notification descriptor, which is the error code
+type sarifDescriptorId struct {
+	Id string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleId              string            `json:"ruleId"`
+	RuleIndex           int               `json:"ruleIndex"`
+	Level               string            `json:"level"`
+	Message             sarifMessage      `json:"message"`
+	Locations           []sarifLocation   `json:"locations,omitempty"`
+	PartialFingerprints map[string]string `json:"partialFingerprints,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	Uri       string `json:"uri"`
+	UriBaseId string `json:"uriBaseId,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine   int           `json:"startLine"`
+	EndLine     int           `json:"endLine,omitempty"`
+	StartColumn int           `json:"startColumn,omitempty"`
+	EndColumn   int           `json:"endColumn,omitempty"`
+	Snippet     *sarifMessage `json:"snippet,omitempty"`
+}
+
+// Get the SARIF level of the rule level
+func sarifLevel(level config.Level) string {
+	switch level {
+	case config.Error:
+		return "error"
+	case config.Warning:
+		return "warning"
+	}
+
+	return "note"
+}
+
+// Write the report as a SARIF 2.1.0 log. Rules which couldn't be evaluated
+// are tool execution notifications instead of results.
+func writeSarif(w io.Writer, report *Report) error {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           toolName,
+				Version:        report.Version,
+				InformationUri: toolUri,
+				Rules:          []sarifRule{},
+			},
+		},
+		Results:    []sarifResult{},
+		ColumnKind: "unicodeCodePoints",
+	}
+	if baseUri := sarifBaseUri(report.ContentPath); baseUri != "" {
+		run.OriginalUriBaseIds = map[string]sarifArtifactLocation{
+			sarifBaseId: {Uri: baseUri},
+		}
+	}
+
+	ruleIndexes := map[string]int{}
+	for i, info := range report.rules() {
+		ruleIndexes[strings.ToLower(info.Id)] = i
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, newSarifRule(info))
+	}
+
+	invocation := sarifInvocation{ExecutionSuccessful: true}
+	seen := occurrences{}
+	for _, result := range report.failures() {
+		info, _ := report.rule(result.Id)
+		ruleIndex, ok := ruleIndexes[strings.ToLower(result.Id)]
+		if !ok {
+			ruleIndex = -1
+		}
+
+		if result.Error != nil {
+			invocation.ExecutionSuccessful = false
+			notification := sarifNotification{
+				Level:          "error",
+				Message:        sarifMessage{Text: result.Error.Err.Error()},
+				Descriptor:     &sarifDescriptorId{Id: string(result.Error.Code)},
+				AssociatedRule: &sarifRuleReference{Id: result.Id, Index: ruleIndex},
+			}
+			if file, line := errorLocation(info, result.Error); file != "" {
+				var region *sarifRegion
+				if line > 0 {
+					region = &sarifRegion{StartLine: line}
+				}
+				notification.Locations = []sarifLocation{newSarifLocation(file, region)}
+			}
+			invocation.ToolExecutionNotifications = append(invocation.ToolExecutionNotifications, notification)
+			continue
+		}
+
+		if result.FileHighlights == nil || len(*result.FileHighlights) == 0 {
+			sarifResult := sarifResult{
+				RuleId:    result.Id,
+				RuleIndex: ruleIndex,
+				Level:     sarifLevel(result.Level),
+				Message:   sarifMessage{Text: result.Description},
+			}
+			file := anchorFile(info)
+			if file != "" {
+				sarifResult.Locations = []sarifLocation{newSarifLocation(file, nil)}
+			}
+			sarifResult.PartialFingerprints = map[string]string{
+				sarifFingerprintKey: fingerprint(result.Id, file, "", seen.next(result.Id, file, "")),
+			}
+			run.Results = append(run.Results, sarifResult)
+			continue
+		}
+
+		for _, highlight := range *result.FileHighlights {
+			file := filepath.ToSlash(highlight.Path)
+			// Path highlights have no line, and SARIF lines start at 1
+			var region *sarifRegion
+			if highlight.LineNumber > 0 {
+				region = &sarifRegion{
+					StartLine:   highlight.LineNumber,
+					StartColumn: highlight.Column,
+					EndColumn:   highlight.EndColumn,
+				}
+				if highlight.LineCount > 1 {
+					region.EndLine = highlight.LineNumber + highlight.LineCount - 1
+				}
+				if highlight.LineContent != "" {
+					region.Snippet = &sarifMessage{Text: highlight.LineContent}
+				}
+			}
+
+			run.Results = append(run.Results, sarifResult{
+				RuleId:    result.Id,
+				RuleIndex: ruleIndex,
+				Level:     sarifLevel(result.Level),
+				Message:   sarifMessage{Text: highlightMessage(result, highlight)},
+				Locations: []sarifLocation{newSarifLocation(file, region)},
+				PartialFingerprints: map[string]string{
+					sarifFingerprintKey: fingerprint(result.Id, file, highlight.LineContent, seen.next(result.Id, file, highlight.LineContent)),
+				},
+			})
+		}
+	}
+	run.Invocations = []sarifInvocation{invocation}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(&sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	})
+}
+
+func newSarifRule(info ruleInfo) sarifRule {
+	rule := info.Rule
+	description := strings.Join(strings.Fields(rule.Description), " ")
+
+	ret := sarifRule{
+		Id:                   info.Id,
+		ShortDescription:     sarifMessage{Text: description},
+		FullDescription:      sarifMessage{Text: description},
+		DefaultConfiguration: sarifConfiguration{Level: sarifLevel(rule.Level)},
+		Properties: map[string]interface{}{
+			"tags":             []string{strings.ToLower(info.Group)},
+			"group":            info.Group,
+			"groupDescription": info.GroupDescription,
+		},
+	}
+
+	// Every rule has help, the description if there's no remediation
+	help := rule.Remediation
+	if help == "" {
+		help = description
+	}
+	ret.Help = &sarifMessage{
+		Text:     help,
+		Markdown: helpMarkdown(help, rule),
+	}
+
+	return ret
+}
+
+// Get the help text of the rule with its examples in Markdown
+func helpMarkdown(help string, rule config.Rule) string {
+	parts := []string{help}
+	if rule.Examples == nil {
+		return help
+	}
+
+	for _, example := range []struct {
+		title    string
+		snippets []string
+	}{
+		{title: "Passes", snippets: rule.Examples.Pass},
+		{title: "Fails", snippets: rule.Examples.Fail},
+	} {
+		if len(example.snippets) == 0 {
+			continue
+		}
+		parts = append(parts, "**"+example.title+":**")
+		for _, snippet := range example.snippets {
+			parts = append(parts, "```\n"+snippet+"\n```")
+		}
+	}
+
+	return strings.Join(parts, "\n\n")
+}
+
+// Get the location in the file, which is relative to the content root. The
+// URI is percent-encoded, like spaces as %20.
+func newSarifLocation(file string, region *sarifRegion) sarifLocation {
+	return sarifLocation{
+		PhysicalLocation: sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{
+				Uri:       (&url.URL{Path: filepath.ToSlash(file)}).EscapedPath(),
+				UriBaseId: sarifBaseId,
+			},
+			Region: region,
+		},
+	}
+}
+
+// Get the URI of the content root, which the artifact URIs are relative to.
+// It's the remote URL for remote repositories.
+func sarifBaseUri(contentPath string) string {
+	if contentPath == "" {
+		return ""
+	}
+
+	original := blueprintrepo.GetOriginalRelPath(contentPath)
+	if u, err := url.Parse(original); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		return strings.TrimSuffix(u.String(), "/") + "/"
+	}
+
+	absPath, err := filepath.Abs(contentPath)
+	if err != nil {
+		return ""
+	}
+	u := url.URL{Scheme: "file", Path: filepath.ToSlash(absPath) + "/"}
+
+	return u.String()
+}
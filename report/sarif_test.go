@@ -0,0 +1,154 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWriteSarif(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, "sarif", testReport()); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	log := sarifLog{}
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("version %s with %d runs, want 2.1.0 with 1 run", log.Version, len(log.Runs))
+	}
+	run := log.Runs[0]
+
+	ruleIds := []string{}
+	for _, rule := range run.Tool.Driver.Rules {
+		ruleIds = append(ruleIds, rule.Id)
+	}
+	if want := []string{"link_0", "link_1", "struct_0", "struct_1"}; !cmp.Equal(ruleIds, want) {
+		t.Errorf("rules: %v", cmp.Diff(ruleIds, want))
+	}
+	if help := run.Tool.Driver.Rules[0].Help; help == nil || help.Text != "Fix the path." {
+		t.Errorf("help of link_0 is %v, want the remediation", help)
+	}
+	for _, rule := range run.Tool.Driver.Rules {
+		if rule.Help == nil || rule.Help.Text == "" {
+			t.Errorf("%s has no help", rule.Id)
+		} else if rule.Id != "link_0" && rule.Help.Text != rule.ShortDescription.Text {
+			t.Errorf("help of %s is %q, want the description", rule.Id, rule.Help.Text)
+		}
+	}
+
+	type result struct {
+		RuleId    string
+		RuleIndex int
+		Level     string
+		Message   string
+		Uri       string
+		Region    *sarifRegion
+	}
+	got := []result{}
+	for _, r := range run.Results {
+		res := result{RuleId: r.RuleId, RuleIndex: r.RuleIndex, Level: r.Level, Message: r.Message.Text}
+		if len(r.Locations) > 0 {
+			res.Uri = r.Locations[0].PhysicalLocation.ArtifactLocation.Uri
+			res.Region = r.Locations[0].PhysicalLocation.Region
+		}
+		if len(r.PartialFingerprints[sarifFingerprintKey]) != 64 {
+			t.Errorf("%s has no fingerprint", r.RuleId)
+		}
+		got = append(got, res)
+	}
+	want := []result{
+		{
+			RuleId:    "link_1",
+			RuleIndex: 1,
+			Level:     "warning",
+			Message:   "Links should have alternative text",
+			Uri:       "blueprint/index.md",
+			Region:    &sarifRegion{StartLine: 3, Snippet: &sarifMessage{Text: "[Docs](https://example.com)"}},
+		},
+		{
+			RuleId:    "link_1",
+			RuleIndex: 1,
+			Level:     "warning",
+			Message:   "Links should have alternative text: no title",
+			Uri:       "blueprint/index.md",
+			Region:    &sarifRegion{StartLine: 9, EndLine: 10, StartColumn: 4, Snippet: &sarifMessage{Text: "[Home](https://example.com)"}},
+		},
+		{
+			RuleId:    "struct_0",
+			RuleIndex: 2,
+			Level:     "error",
+			Message:   "The README.md must exist",
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("results: %v", cmp.Diff(got, want))
+	}
+
+	invocation := run.Invocations[0]
+	if invocation.ExecutionSuccessful || len(invocation.ToolExecutionNotifications) != 1 {
+		t.Fatalf("invocation %v, want one notification", invocation)
+	}
+	notification := invocation.ToolExecutionNotifications[0]
+	if notification.Descriptor.Id != "file-not-found" || notification.AssociatedRule.Id != "struct_1" || notification.Message.Text != "open schemas/config.schema.json: no such file or directory" {
+		t.Errorf("notification %v, want the error of struct_1", notification)
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	a := fingerprint("LINK_1", "index.md", "  [Docs](https://example.com)", 1)
+	b := fingerprint("link_1", "index.md", "[Docs](https://example.com)", 1)
+	if a != b {
+		t.Errorf("fingerprints must not depend on the case of the ID or the indentation")
+	}
+	if a == fingerprint("link_1", "index.md", "[Docs](https://example.com)", 2) {
+		t.Errorf("fingerprints of identical findings must differ")
+	}
+}
+
+func TestWriteSarif_Path(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, "sarif", testPathReport()); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	log := sarifLog{}
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	results := log.Runs[0].Results
+	if len(results) != 1 || len(results[0].Locations) != 1 {
+		t.Fatalf("results %v, want one with a location", results)
+	}
+	location := results[0].Locations[0].PhysicalLocation
+	if location.ArtifactLocation.Uri != "blueprint/.env" || location.Region != nil {
+		t.Errorf("location %v, want blueprint/.env without a region", location)
+	}
+	if strings.Contains(buf.String(), "startLine") {
+		t.Errorf("path highlights must not have a start line:\n%s", buf.String())
+	}
+}
+
+func TestNewSarifLocation(t *testing.T) {
+	tests := []struct {
+		file string
+		want string
+	}{
+		{file: "blueprint/index.md", want: "blueprint/index.md"},
+		{file: "my docs/100%.md", want: "my%20docs/100%25.md"},
+		{file: "blueprint/#1?.md", want: "blueprint/%231%3F.md"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			got := newSarifLocation(tt.file, nil).PhysicalLocation.ArtifactLocation.Uri
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package ruletest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
+	"github.com/PrinceMerluza/devcenter-content-linter/linter"
+	"gopkg.in/yaml.v3"
+)
+
+// Suffix of the sidecar file next to a fixture folder. The expected findings
+// of fixtures/broken-link are in fixtures/broken-link.expected.yaml.
+const ExpectedSuffix = ".expected.yaml"
+
+// A failure of a rule. File and Line are empty for rules which fail without
+// highlighting a line, like a missing file.
+type Finding struct {
+	Rule string `yaml:"rule"`
+	File string `yaml:"file,omitempty"`
+	Line int    `yaml:"line,omitempty"`
+}
+
+func (f Finding) String() string {
+	switch {
+	case f.File == "":
+		return f.Rule
+	case f.Line == 0:
+		return fmt.Sprintf("%s %s", f.Rule, f.File)
+	}
+
+	return fmt.Sprintf("%s %s:%d", f.Rule, f.File, f.Line)
+}
+
+// A rule which couldn't be evaluated, like a rule whose file is missing
+type RuleError struct {
+	Rule    string
+	Message string
+}
+
+func (e RuleError) String() string {
+	return fmt.Sprintf("%s: %s", e.Rule, e.Message)
+}
+
+// Content of the sidecar file. Errors are the IDs of the rules expected to
+// fail with an error instead of findings.
+type Expectation struct {
+	Findings []Finding `yaml:"findings"`
+	Errors   []string  `yaml:"errors,omitempty"`
+}
+
+// A folder of content with the findings expected from the rule set
+type Fixture struct {
+	Name         string // path of the folder relative to the fixtures directory
+	Path         string
+	ExpectedPath string
+}
+
+// Result of running the rule set against a fixture
+type Report struct {
+	Fixture          Fixture
+	Missing          []Finding   // expected but not found
+	Unexpected       []Finding   // found but not expected
+	Findings         []Finding   // everything that was found
+	MissingErrors    []string    // rules expected to error which didn't
+	UnexpectedErrors []RuleError // rules which errored but weren't expected to
+	Errors           []RuleError // every rule which errored
+}
+
+func (r *Report) Passed() bool {
+	return len(r.Missing) == 0 && len(r.Unexpected) == 0 && len(r.MissingErrors) == 0 && len(r.UnexpectedErrors) == 0
+}
+
+// Get what was found as the content of the sidecar file
+func (r *Report) Expectation() *Expectation {
+	expectation := &Expectation{Findings: r.Findings}
+	for _, ruleError := range r.Errors {
+		expectation.Errors = append(expectation.Errors, ruleError.Rule)
+	}
+
+	return expectation
+}
+
+// Find the fixtures in dir and its subdirectories, ordered by name
+func Discover(dir string) ([]Fixture, error) {
+	ret := []Fixture{}
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ExpectedSuffix) {
+			return nil
+		}
+
+		fixturePath := strings.TrimSuffix(path, ExpectedSuffix)
+		info, err := os.Stat(fixturePath)
+		if err != nil || !info.IsDir() {
+			return fmt.Errorf("%s: no fixture folder %s", path, fixturePath)
+		}
+
+		name, err := filepath.Rel(dir, fixturePath)
+		if err != nil {
+			return err
+		}
+		ret = append(ret, Fixture{
+			Name:         filepath.ToSlash(name),
+			Path:         fixturePath,
+			ExpectedPath: path,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+
+	return ret, nil
+}
+
+// Run the compiled rule set against the fixture and compare the findings with
+// the expected ones. Rule IDs are case-insensitive.
+func Run(fixture Fixture, plan *linter.Plan) (*Report, error) {
+	expected, err := LoadExpected(fixture.ExpectedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Highlighted paths are relative to the fixture
+	blueprintrepo.UseRepo(fixture.Path, false)
+	validationData := &linter.ValidationData{
+		ContentPath: fixture.Path,
+		Plan:        plan,
+	}
+	result, err := validationData.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	found := Findings(result)
+	report := &Report{
+		Fixture:          fixture,
+		Missing:          difference(normalize(expected.Findings), found),
+		Unexpected:       difference(found, normalize(expected.Findings)),
+		Findings:         found,
+		MissingErrors:    []string{},
+		UnexpectedErrors: []RuleError{},
+		Errors:           Errors(result),
+	}
+
+	expectedErrors := map[string]bool{}
+	for _, rule := range expected.Errors {
+		expectedErrors[strings.ToLower(rule)] = true
+	}
+	for _, ruleError := range report.Errors {
+		if !expectedErrors[ruleError.Rule] {
+			report.UnexpectedErrors = append(report.UnexpectedErrors, ruleError)
+		}
+		delete(expectedErrors, ruleError.Rule)
+	}
+	for rule := range expectedErrors {
+		report.MissingErrors = append(report.MissingErrors, rule)
+	}
+	sort.Strings(report.MissingErrors)
+
+	return report, nil
+}
+
+// Load the expected findings of the sidecar file
+func LoadExpected(path string) (*Expectation, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	expectation := &Expectation{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	// An empty file expects no findings
+	if err := decoder.Decode(expectation); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return expectation, nil
+}
+
+// Write the expectation to the sidecar file
+func SaveExpected(path string, expectation *Expectation) error {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(expectation); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// Get the findings of the failed rules, one for each highlighted line. Rules
+// which couldn't be evaluated have no findings, see Errors.
+func Findings(result *linter.ValidationResult) []Finding {
+	ret := []Finding{}
+	if result.FailureResults == nil {
+		return ret
+	}
+
+	for _, ruleResult := range *result.FailureResults {
+		if ruleResult.Error != nil {
+			continue
+		}
+		if ruleResult.FileHighlights == nil || len(*ruleResult.FileHighlights) == 0 {
+			ret = append(ret, Finding{Rule: ruleResult.Id})
+			continue
+		}
+		for _, highlight := range *ruleResult.FileHighlights {
+			ret = append(ret, Finding{
+				Rule: ruleResult.Id,
+				File: filepath.ToSlash(highlight.Path),
+				Line: highlight.LineNumber,
+			})
+		}
+	}
+
+	return normalize(ret)
+}
+
+// Get the rules which couldn't be evaluated, with lowercase IDs and sorted
+func Errors(result *linter.ValidationResult) []RuleError {
+	ret := []RuleError{}
+	if result.FailureResults == nil {
+		return ret
+	}
+
+	for _, ruleResult := range *result.FailureResults {
+		if ruleResult.Error == nil {
+			continue
+		}
+		ret = append(ret, RuleError{
+			Rule:    strings.ToLower(ruleResult.Id),
+			Message: ruleResult.Error.Error(),
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Rule < ret[j].Rule
+	})
+
+	return ret
+}
+
+// Get the findings with lowercase rule IDs, sorted and without duplicates
+func normalize(findings []Finding) []Finding {
+	ret := []Finding{}
+	seen := map[Finding]bool{}
+	for _, finding := range findings {
+		finding.Rule = strings.ToLower(finding.Rule)
+		if seen[finding] {
+			continue
+		}
+		seen[finding] = true
+		ret = append(ret, finding)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		a, b := ret[i], ret[j]
+		if a.Rule != b.Rule {
+			return a.Rule < b.Rule
+		}
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Line < b.Line
+	})
+
+	return ret
+}
+
+// Get the findings of a which aren't in b
+func difference(a []Finding, b []Finding) []Finding {
+	inB := map[Finding]bool{}
+	for _, finding := range b {
+		inB[finding] = true
+	}
+
+	ret := []Finding{}
+	for _, finding := range a {
+		if !inB[finding] {
+			ret = append(ret, finding)
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,161 @@
+package ruletest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/linter"
+	"github.com/PrinceMerluza/devcenter-content-linter/presets"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+const testRuleSet = "test/test.rule.yaml"
+
+func TestDiscover(t *testing.T) {
+	fixtures, err := Discover("test/fixtures")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	got := []string{}
+	for _, fixture := range fixtures {
+		got = append(got, fixture.Name)
+	}
+	want := []string{"clean", "nested/missing-readme", "todo"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("%v", cmp.Diff(got, want))
+	}
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name                 string
+		fixture              Fixture
+		wantMissing          []Finding
+		wantUnexpected       []Finding
+		wantMissingErrors    []string
+		wantUnexpectedErrors []string
+	}{
+		{
+			name:           "No Findings",
+			fixture:        Fixture{Path: "test/fixtures/clean", ExpectedPath: "test/fixtures/clean.expected.yaml"},
+			wantMissing:    []Finding{},
+			wantUnexpected: []Finding{},
+		},
+		{
+			name:           "Highlighted Finding",
+			fixture:        Fixture{Path: "test/fixtures/todo", ExpectedPath: "test/fixtures/todo.expected.yaml"},
+			wantMissing:    []Finding{},
+			wantUnexpected: []Finding{},
+		},
+		{
+			name:           "Finding Without Highlight",
+			fixture:        Fixture{Path: "test/fixtures/nested/missing-readme", ExpectedPath: "test/fixtures/nested/missing-readme.expected.yaml"},
+			wantMissing:    []Finding{},
+			wantUnexpected: []Finding{},
+		},
+		{
+			name:    "Wrong Line",
+			fixture: Fixture{Path: "test/mismatch/todo", ExpectedPath: "test/mismatch/todo.expected.yaml"},
+			wantMissing: []Finding{
+				{Rule: "content_0", File: "README.md", Line: 2},
+			},
+			wantUnexpected: []Finding{
+				{Rule: "content_0", File: "README.md", Line: 3},
+			},
+		},
+		{
+			name:                 "Unexpected Error",
+			fixture:              Fixture{Path: "test/mismatch/missing-readme", ExpectedPath: "test/mismatch/missing-readme.expected.yaml"},
+			wantMissing:          []Finding{{Rule: "content_0"}},
+			wantUnexpected:       []Finding{},
+			wantUnexpectedErrors: []string{"content_0"},
+		},
+		{
+			name:              "Missing Error",
+			fixture:           Fixture{Path: "test/mismatch/todo-error", ExpectedPath: "test/mismatch/todo-error.expected.yaml"},
+			wantMissing:       []Finding{},
+			wantUnexpected:    []Finding{},
+			wantMissingErrors: []string{"content_0"},
+		},
+	}
+
+	ruleSet, err := config.LoadRuleSet(testRuleSet)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	plan, err := linter.Compile(ruleSet, testRuleSet)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := Run(tt.fixture, plan)
+			if err != nil {
+				t.Fatalf("Error: %v", err)
+			}
+			if !cmp.Equal(report.Missing, tt.wantMissing) {
+				t.Errorf("missing: %v", cmp.Diff(report.Missing, tt.wantMissing))
+			}
+			if !cmp.Equal(report.Unexpected, tt.wantUnexpected) {
+				t.Errorf("unexpected: %v", cmp.Diff(report.Unexpected, tt.wantUnexpected))
+			}
+			if !cmp.Equal(report.MissingErrors, tt.wantMissingErrors, cmpopts.EquateEmpty()) {
+				t.Errorf("missing errors: %v", cmp.Diff(report.MissingErrors, tt.wantMissingErrors, cmpopts.EquateEmpty()))
+			}
+			unexpectedErrors := []string{}
+			for _, ruleError := range report.UnexpectedErrors {
+				unexpectedErrors = append(unexpectedErrors, ruleError.Rule)
+			}
+			if !cmp.Equal(unexpectedErrors, tt.wantUnexpectedErrors, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected errors: %v", cmp.Diff(unexpectedErrors, tt.wantUnexpectedErrors, cmpopts.EquateEmpty()))
+			}
+			if wantPassed := len(tt.wantMissing)+len(tt.wantUnexpected)+len(tt.wantMissingErrors)+len(tt.wantUnexpectedErrors) == 0; report.Passed() != wantPassed {
+				t.Errorf("passed is %v, want %v", report.Passed(), wantPassed)
+			}
+		})
+	}
+}
+
+// The built-in rule sets must find what their fixtures expect
+func TestRun_Presets(t *testing.T) {
+	for _, preset := range presets.Names() {
+		dir := filepath.Join("../presets/fixtures", preset)
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			continue
+		}
+
+		ruleSet, err := config.ResolvePreset(preset)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		plan, err := linter.Compile(ruleSet, "")
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		fixtures, err := Discover(dir)
+		if err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+		for _, fixture := range fixtures {
+			t.Run(preset+"/"+fixture.Name, func(t *testing.T) {
+				report, err := Run(fixture, plan)
+				if err != nil {
+					t.Fatalf("Error: %v", err)
+				}
+				for _, finding := range report.Missing {
+					t.Errorf("expected, not found: %s", finding)
+				}
+				for _, finding := range report.Unexpected {
+					t.Errorf("found, not expected: %s", finding)
+				}
+				for _, ruleError := range report.UnexpectedErrors {
+					t.Errorf("error, not expected: %s", ruleError)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+const templateExt = ".tmpl"
+
+// Templates of the content for each preset. Files ending with .tmpl are Go
+// templates, everything else is copied as is.
+//
+//go:embed templates
+var templates embed.FS
+
+var funcs = template.FuncMap{
+	"yaml": yamlScalar,
+}
+
+// Values used in the templates
+type Data struct {
+	Title   string
+	Author  string
+	Summary string
+	// Publishing date in the YYYY-MM-DD format
+	Date string
+}
+
+// Get the names of the presets which can be scaffolded
+func Presets() []string {
+	ret := []string{}
+
+	entries, err := templates.ReadDir("templates")
+	if err != nil {
+		return ret
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			ret = append(ret, entry.Name())
+		}
+	}
+	sort.Strings(ret)
+
+	return ret
+}
+
+// Create the files of the preset in dir and get their paths. Nothing is
+// written if any of the files already exists.
+func Create(dir string, preset string, data *Data) ([]string, error) {
+	root := path.Join("templates", preset)
+	if _, err := fs.Stat(templates, root); err != nil {
+		return nil, fmt.Errorf("unknown preset %s, must be one of: %s", preset, strings.Join(Presets(), ", "))
+	}
+
+	files := map[string][]byte{}
+	dirs := []string{}
+	err := fs.WalkDir(templates, root, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath := strings.TrimPrefix(strings.TrimPrefix(filePath, root), "/")
+		target := filepath.Join(dir, filepath.FromSlash(strings.TrimSuffix(relPath, templateExt)))
+		if d.IsDir() {
+			dirs = append(dirs, target)
+			return nil
+		}
+
+		content, err := templates.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+
+		if strings.HasSuffix(filePath, templateExt) {
+			tmpl, err := template.New(relPath).Funcs(funcs).Parse(string(content))
+			if err != nil {
+				return err
+			}
+
+			var buf bytes.Buffer
+			if err := tmpl.Execute(&buf, data); err != nil {
+				return err
+			}
+			content = buf.Bytes()
+		}
+
+		if _, err := os.Stat(target); err == nil {
+			return fmt.Errorf("%s already exists", target)
+		}
+		files[target] = content
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dirPath := range dirs {
+		if err := os.MkdirAll(dirPath, 0755); err != nil {
+			return nil, err
+		}
+	}
+
+	created := []string{}
+	for target, content := range files {
+		if err := os.WriteFile(target, content, 0644); err != nil {
+			return nil, err
+		}
+		created = append(created, target)
+	}
+	sort.Strings(created)
+
+	return created, nil
+}
+
+// Get a readable title from a directory name like my-chat-bot-blueprint
+func TitleFromDirName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '-' || r == '_' || r == ' '
+	})
+	for i, word := range words {
+		first, size := utf8.DecodeRuneInString(word)
+		words[i] = string(unicode.ToUpper(first)) + word[size:]
+	}
+
+	return strings.Join(words, " ")
+}
+
+// Format the value as a YAML scalar, quoting it only if needed
+func yamlScalar(value string) (string, error) {
+	out, err := yaml.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
@@ -0,0 +1,76 @@
+package scaffold
+
+import (
+	"testing"
+
+	"github.com/PrinceMerluza/devcenter-content-linter/blueprintrepo"
+	"github.com/PrinceMerluza/devcenter-content-linter/config"
+	"github.com/PrinceMerluza/devcenter-content-linter/linter"
+)
+
+// The scaffold must pass the rules of its preset out of the box
+func TestCreate(t *testing.T) {
+	tests := []struct {
+		preset string
+	}{
+		{preset: "article"},
+		{preset: "blogpost"},
+		{preset: "blueprint"},
+		{preset: "guide"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.preset, func(t *testing.T) {
+			dir := t.TempDir()
+			data := &Data{
+				Title:   "Chat bot: part 1",
+				Author:  "jdoe",
+				Summary: "Build a chat bot.",
+				Date:    "2022-05-01",
+			}
+
+			if _, err := Create(dir, tt.preset, data); err != nil {
+				t.Fatalf("Error: %v", err)
+			}
+			if _, err := Create(dir, tt.preset, data); err == nil {
+				t.Errorf("existing files must not be overwritten")
+			}
+
+			ruleSet, err := config.ResolvePreset(tt.preset)
+			if err != nil {
+				t.Fatalf("Error: %v", err)
+			}
+			blueprintrepo.UseRepo(dir, false)
+			validationData := &linter.ValidationData{
+				ContentPath: dir,
+				RuleData:    ruleSet,
+			}
+			result, err := validationData.Validate()
+			if err != nil {
+				t.Fatalf("Error: %v", err)
+			}
+
+			for _, failure := range *result.FailureResults {
+				t.Errorf("%s failed: %s", failure.Id, failure.Description)
+			}
+		})
+	}
+}
+
+func TestTitleFromDirName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "my-chat-bot-blueprint", want: "My Chat Bot Blueprint"},
+		{name: "web_chat  deploy", want: "Web Chat Deploy"},
+		{name: "élan-blueprint", want: "Élan Blueprint"},
+		{name: "ñandú", want: "Ñandú"},
+		{name: "--", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := TitleFromDirName(tt.name); got != tt.want {
+			t.Errorf("TitleFromDirName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package schemas
+
+import _ "embed"
+
+// JSON Schema of the rule set configuration
+//
+//go:embed linter-rules.schema.json
+var RuleSetSchema []byte
+
+// JSON Schema of the result document of the json format
+//
+//go:embed result.schema.json
+var ResultSchema []byte
@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Compile a glob pattern to a regular expression. Supports *, ?, character
+// classes, {a,b} alternatives and ** for matching across directories.
+func CompileGlob(pattern string) (*regexp.Regexp, error) {
+	pattern = strings.TrimPrefix(filepath.ToSlash(pattern), "./")
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	inGroup := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					sb.WriteString("(?:.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+				continue
+			}
+			sb.WriteString("[^/]*")
+		case '?':
+			sb.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i:], ']')
+			if end < 0 {
+				sb.WriteString(`\[`)
+				continue
+			}
+			class := pattern[i+1 : i+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + class + "]")
+			i += end
+		case '{':
+			inGroup = true
+			sb.WriteString("(?:")
+		case '}':
+			if !inGroup {
+				sb.WriteString(`\}`)
+				continue
+			}
+			inGroup = false
+			sb.WriteString(")")
+		case ',':
+			if inGroup {
+				sb.WriteString("|")
+				continue
+			}
+			sb.WriteString(",")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	sb.WriteString("$")
+
+	return regexp.Compile(sb.String())
+}
+
+// Compile the glob patterns with CompileGlob
+func CompileGlobs(patterns []string) ([]*regexp.Regexp, error) {
+	ret := []*regexp.Regexp{}
+	for _, pattern := range patterns {
+		re, err := CompileGlob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, re)
+	}
+
+	return ret, nil
+}
+
+// Walk the root directory and get all the files and directories matching any
+// of the compiled glob patterns. Patterns are relative to the root. The .git
+// directory is always skipped.
+func Glob(root string, globs []*regexp.Regexp) ([]string, error) {
+	matches := []string{}
+	err := WalkRel(root, func(path string, relPath string, d fs.DirEntry) error {
+		for _, re := range globs {
+			if re.MatchString(relPath) {
+				matches = append(matches, path)
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// Walk the files and directories under the root, with their path relative to
+// the root in forward slashes for matching globs. The root itself and the .git
+// directory are skipped, and the first error stops the walk. fn may return
+// filepath.SkipDir to skip a directory.
+func WalkRel(root string, fn func(path string, relPath string, d fs.DirEntry) error) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == root {
+			return nil
+		}
+		if d.IsDir() && d.Name() == ".git" {
+			return filepath.SkipDir
+		}
+
+		relPath, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		return fn(path, filepath.ToSlash(relPath), d)
+	})
+}
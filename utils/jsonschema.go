@@ -0,0 +1,251 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/qri-io/jsonschema"
+	"gopkg.in/yaml.v3"
+)
+
+const additionalPropertiesMessage = "additional properties are not allowed"
+
+// The jsonschema package keeps a global schema registry which is not safe
+// for concurrent use, and rules are evaluated in goroutines.
+var jsonSchemaMu sync.Mutex
+
+// A keyword error from validating a document against a JSON Schema
+type SchemaViolation struct {
+	Pointer string // JSON pointer to the invalid value
+	Line    int
+	Message string
+}
+
+// The document could not be parsed
+type SyntaxError struct {
+	Line int
+	Err  error
+}
+
+func (e *SyntaxError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *SyntaxError) Unwrap() error {
+	return e.Err
+}
+
+// Validate the JSON or YAML data against the JSON Schema. Each violation is
+// mapped to the line of the invalid value in the data. A *SyntaxError is
+// returned if the data can't be parsed.
+func ValidateJsonSchema(schemaData []byte, data []byte, isJson bool) ([]SchemaViolation, error) {
+	dataString := string(data)
+
+	// YAML is a superset of JSON so both are parsed the same way, keeping
+	// the node positions. JSON is checked separately first since the YAML
+	// parser accepts some invalid JSON.
+	if isJson {
+		var tmp interface{}
+		if err := json.Unmarshal(data, &tmp); err != nil {
+			line := 0
+			if jsonErr, ok := err.(*json.SyntaxError); ok {
+				line = LineAtOffset(dataString, int(jsonErr.Offset))
+			}
+			return nil, &SyntaxError{Line: line, Err: err}
+		}
+	}
+
+	root := &yaml.Node{}
+	if err := yaml.Unmarshal(data, root); err != nil {
+		return nil, &SyntaxError{Line: YamlErrorLine(err), Err: err}
+	}
+
+	var doc interface{}
+	if err := root.Decode(&doc); err != nil {
+		return nil, err
+	}
+	docJson, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonSchemaMu.Lock()
+	defer jsonSchemaMu.Unlock()
+
+	schema := &jsonschema.Schema{}
+	if err := json.Unmarshal(schemaData, schema); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+
+	keyErrors, err := schema.ValidateBytes(context.Background(), docJson)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawSchema interface{}
+	if err := json.Unmarshal(schemaData, &rawSchema); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+
+	violations := []SchemaViolation{}
+	for _, keyError := range keyErrors {
+		node := YamlNodeAtPointer(root, keyError.PropertyPath)
+
+		// The validator doesn't say which properties are not allowed
+		if keyError.Message == additionalPropertiesMessage {
+			if unknown := unknownProperties(rawSchema, node, keyError.PropertyPath); len(unknown) > 0 {
+				violations = append(violations, unknown...)
+				continue
+			}
+		}
+
+		// An empty document has no node with a line, so the violation is at
+		// the start of the file
+		line := 1
+		if node != nil && node.Line > 0 {
+			line = node.Line
+		}
+
+		violations = append(violations, SchemaViolation{
+			Pointer: keyError.PropertyPath,
+			Line:    line,
+			Message: keyError.Message,
+		})
+	}
+
+	// Keyword evaluation order is not guaranteed
+	sort.SliceStable(violations, func(i, j int) bool {
+		if violations[i].Line != violations[j].Line {
+			return violations[i].Line < violations[j].Line
+		}
+		return violations[i].Message < violations[j].Message
+	})
+
+	return violations, nil
+}
+
+// Read a JSON or YAML file and return it as JSON
+func LoadAsJson(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(doc)
+}
+
+// Get a violation for each key of the mapping node which is not defined in the
+// schema's properties or patternProperties
+func unknownProperties(schema interface{}, node *yaml.Node, pointer string) []SchemaViolation {
+	subschema := subschemaAtPointer(schema, pointer)
+	if subschema == nil || node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	violations := []SchemaViolation{}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i].Value
+		if isDefinedProperty(subschema, key) {
+			continue
+		}
+
+		escaped := strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+		violations = append(violations, SchemaViolation{
+			Pointer: strings.TrimSuffix(pointer, "/") + "/" + escaped,
+			Line:    node.Content[i].Line,
+			Message: fmt.Sprintf("property %q is not allowed", key),
+		})
+	}
+
+	return violations
+}
+
+// Find the subschema which applies to the value at the JSON pointer. Only
+// properties, patternProperties, additionalProperties and items are followed.
+func subschemaAtPointer(schema interface{}, pointer string) map[string]interface{} {
+	root, _ := schema.(map[string]interface{})
+	current := root
+
+	for _, token := range splitPointer(pointer) {
+		current = ResolveSchemaRef(root, current)
+		if current == nil {
+			return nil
+		}
+
+		var next map[string]interface{}
+		if props, ok := current["properties"].(map[string]interface{}); ok {
+			next, _ = props[token].(map[string]interface{})
+		}
+		if patterns, ok := current["patternProperties"].(map[string]interface{}); ok && next == nil {
+			for pattern, sub := range patterns {
+				if re, err := regexp.Compile(pattern); err == nil && re.MatchString(token) {
+					next, _ = sub.(map[string]interface{})
+					break
+				}
+			}
+		}
+		if items, ok := current["items"].(map[string]interface{}); ok && next == nil {
+			if _, err := strconv.Atoi(token); err == nil {
+				next = items
+			}
+		}
+		if additional, ok := current["additionalProperties"].(map[string]interface{}); ok && next == nil {
+			next = additional
+		}
+
+		current = next
+	}
+
+	return ResolveSchemaRef(root, current)
+}
+
+// Follow the $ref of the schema if it's a local reference like
+// #/$defs/name
+func ResolveSchemaRef(root map[string]interface{}, schema map[string]interface{}) map[string]interface{} {
+	for schema != nil {
+		ref, ok := schema["$ref"].(string)
+		if !ok || !strings.HasPrefix(ref, "#") {
+			return schema
+		}
+
+		var target interface{} = root
+		for _, token := range splitPointer(strings.TrimPrefix(ref, "#")) {
+			obj, _ := target.(map[string]interface{})
+			target = obj[token]
+		}
+		schema, _ = target.(map[string]interface{})
+	}
+
+	return nil
+}
+
+// Check if the key is defined in the schema's properties or patternProperties
+func isDefinedProperty(schema map[string]interface{}, key string) bool {
+	if props, ok := schema["properties"].(map[string]interface{}); ok {
+		if _, ok := props[key]; ok {
+			return true
+		}
+	}
+
+	if patterns, ok := schema["patternProperties"].(map[string]interface{}); ok {
+		for pattern := range patterns {
+			if re, err := regexp.Compile(pattern); err == nil && re.MatchString(key) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var yamlErrLineRe = regexp.MustCompile(`line (\d+)`)
+
+// Get the 1-based line number of an offset in the data string
+func LineAtOffset(data string, offset int) int {
+	if offset > len(data) {
+		offset = len(data)
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return strings.Count(data[:offset], "\n") + 1
+}
+
+// Get the line number reported in a YAML parser error. Returns 0 if the
+// error doesn't contain any.
+func YamlErrorLine(err error) int {
+	match := yamlErrLineRe.FindStringSubmatch(err.Error())
+	if match == nil {
+		return 0
+	}
+
+	line, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0
+	}
+
+	return line
+}
+
+// Find the node referenced by the JSON pointer. If the pointer can't be fully
+// resolved, the deepest node found is returned so the caller can still
+// point to the closest location in the file.
+func YamlNodeAtPointer(root *yaml.Node, pointer string) *yaml.Node {
+	node := root
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	for _, token := range splitPointer(pointer) {
+		var next *yaml.Node
+		switch node.Kind {
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == token {
+					next = node.Content[i+1]
+					break
+				}
+			}
+		case yaml.SequenceNode:
+			index, err := strconv.Atoi(token)
+			if err == nil && index >= 0 && index < len(node.Content) {
+				next = node.Content[index]
+			}
+		}
+
+		// Nodes without a position, like the value of an empty document,
+		// are skipped in favour of their parent
+		if next == nil || next.Line == 0 {
+			return node
+		}
+		node = next
+	}
+
+	return node
+}
+
+// Get the unescaped reference tokens of the JSON pointer
+func splitPointer(pointer string) []string {
+	tokens := []string{}
+	for _, token := range strings.Split(strings.Trim(pointer, "/"), "/") {
+		if token == "" {
+			continue
+		}
+		tokens = append(tokens, strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~"))
+	}
+
+	return tokens
+}
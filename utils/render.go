@@ -2,24 +2,25 @@ package utils
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/PrinceMerluza/devcenter-content-linter/transform_data"
 	"github.com/tidwall/pretty"
 )
 
-func Render(data string) {
+func Render(w io.Writer, data string) {
 	if transform_data.TemplateFile != "" {
 		mp := transform_data.ConvertJsonToMap(data)
 		res := transform_data.ProcessTemplateFile(mp)
-		fmt.Println(res)
+		fmt.Fprintln(w, res)
 		return
 	}
 	if transform_data.TemplateStr != "" {
 		mp := transform_data.ConvertJsonToMap(data)
 		res := transform_data.ProcessTemplateStr(mp)
-		fmt.Println(res)
+		fmt.Fprintln(w, res)
 		return
 	}
 	result := pretty.Pretty([]byte(data))
-	fmt.Printf("%s", result)
+	fmt.Fprintf(w, "%s", result)
 }
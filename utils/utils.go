@@ -16,7 +16,7 @@ func GetStringAtLine(s string, line int) (string, error) {
 
 	// adjust line number to 0-based index
 	aLine := line - 1
-	if aLine > len(lines) {
+	if aLine < 0 || aLine >= len(lines) {
 		return "", errors.New("line number out of range")
 	}
 